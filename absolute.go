@@ -0,0 +1,120 @@
+package anirent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	pb "github.com/Zaba505/anirent/proto"
+)
+
+// SeasonOffset marks the absolute number of the first episode of a season.
+type SeasonOffset struct {
+	Season int64 `json:"season"`
+	First  int64 `json:"first"`
+}
+
+// AbsoluteMapping maps anime names to the offsets of their seasons, which
+// are used to convert absolute episode numbers e.g. One Piece - 1071 into
+// season and episode pairs. Names are matched case insensitively.
+type AbsoluteMapping map[string][]SeasonOffset
+
+// ReadAbsoluteMapping reads an absolute mapping encoded as JSON e.g.
+//
+//	{"One Piece": [{"season": 1, "first": 1}, {"season": 21, "first": 892}]}
+func ReadAbsoluteMapping(r io.Reader) (AbsoluteMapping, error) {
+	var m AbsoluteMapping
+	err := json.NewDecoder(r).Decode(&m)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, offsets := range m {
+		for _, offset := range offsets {
+			if offset.Season < 1 || offset.First < 1 {
+				return nil, fmt.Errorf("anirent: invalid season offset for %s - season %d starting at %d", name, offset.Season, offset.First)
+			}
+		}
+	}
+	return m, nil
+}
+
+// offsets returns the season offsets for the given anime name.
+func (m AbsoluteMapping) offsets(name string) []SeasonOffset {
+	for title, offsets := range m {
+		if strings.EqualFold(title, name) {
+			return offsets
+		}
+	}
+	return nil
+}
+
+// Apply converts the absolute episode numbers of the result into season
+// and episode pairs, keeping the absolute number on each episode. Results
+// which already name a season other than the first are left as is. It
+// reports whether the result was changed.
+func (m AbsoluteMapping) Apply(result *pb.SearchResult) bool {
+	offsets := m.offsets(result.Name)
+	if len(offsets) == 0 {
+		return false
+	}
+
+	switch x := result.Details.(type) {
+	case *pb.SearchResult_Episode:
+		return mapAbsolute(x.Episode, offsets)
+	case *pb.SearchResult_Season:
+		batch := x.Season
+		if batch.Number != 1 {
+			return false
+		}
+
+		mapped := false
+		for _, ep := range batch.Episodes {
+			if mapAbsolute(ep, offsets) {
+				mapped = true
+			}
+		}
+		if !mapped {
+			return false
+		}
+
+		// A batch only moves to another season if all of its
+		// episodes belong to it.
+		season := batch.Episodes[0].Season
+		for _, ep := range batch.Episodes[1:] {
+			if ep.Season != season {
+				return true
+			}
+		}
+		batch.Number = season
+		return true
+	}
+	return false
+}
+
+// mapAbsolute maps the absolute number of the episode onto the season whose
+// first episode is the closest one before it.
+func mapAbsolute(ep *pb.Episode, offsets []SeasonOffset) bool {
+	if ep.Season != 1 || ep.Absolute != 0 {
+		return false
+	}
+
+	var season *SeasonOffset
+	for i, offset := range offsets {
+		if offset.First > ep.Number {
+			continue
+		}
+		if season == nil || offset.First > season.First {
+			season = &offsets[i]
+		}
+	}
+	if season == nil {
+		return false
+	}
+
+	ep.Absolute = ep.Number
+	ep.Season = season.Season
+	ep.Number = ep.Number - season.First + 1
+	return true
+}
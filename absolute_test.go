@@ -0,0 +1,108 @@
+package anirent
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbsoluteMappingApply(t *testing.T) {
+	m := AbsoluteMapping{
+		"One Piece": {
+			{Season: 1, First: 1},
+			{Season: 20, First: 892},
+			{Season: 21, First: 1086},
+		},
+	}
+
+	result := func(name string, season, number int64) *pb.SearchResult {
+		return &pb.SearchResult{Name: name, Details: episode(season, number)}
+	}
+	mapped := func(season, number, absolute int64) *pb.SearchResult_Episode {
+		return &pb.SearchResult_Episode{
+			Episode: &pb.Episode{Season: season, Number: number, Absolute: absolute},
+		}
+	}
+
+	testCases := []struct {
+		Name     string
+		Result   *pb.SearchResult
+		Mapped   bool
+		Expected interface{}
+	}{
+		{
+			Name:     "Absolute Episode",
+			Result:   result("One Piece", 1, 1071),
+			Mapped:   true,
+			Expected: mapped(20, 180, 1071),
+		},
+		{
+			Name:     "First Episode Of Season",
+			Result:   result("one piece", 1, 1086),
+			Mapped:   true,
+			Expected: mapped(21, 1, 1086),
+		},
+		{
+			Name:     "First Season",
+			Result:   result("One Piece", 1, 8),
+			Mapped:   true,
+			Expected: mapped(1, 8, 8),
+		},
+		{
+			Name:     "Explicit Season",
+			Result:   result("One Piece", 2, 8),
+			Expected: episode(2, 8),
+		},
+		{
+			Name:     "Unmapped Anime",
+			Result:   result("Tonikaku Kawaii", 1, 8),
+			Expected: episode(1, 8),
+		},
+		{
+			Name: "Batch Within A Season",
+			Result: &pb.SearchResult{
+				Name: "One Piece",
+				Details: &pb.SearchResult_Season{
+					Season: &pb.CompleteSeason{
+						Number: 1,
+						Episodes: []*pb.Episode{
+							{Season: 1, Number: 1086},
+							{Season: 1, Number: 1087},
+						},
+					},
+				},
+			},
+			Mapped: true,
+			Expected: &pb.SearchResult_Season{
+				Season: &pb.CompleteSeason{
+					Number: 21,
+					Episodes: []*pb.Episode{
+						{Season: 21, Number: 1, Absolute: 1086},
+						{Season: 21, Number: 2, Absolute: 1087},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			assert.Equal(subT, testCase.Mapped, m.Apply(testCase.Result))
+			assert.Equal(subT, testCase.Expected, testCase.Result.Details)
+		})
+	}
+}
+
+func TestReadAbsoluteMapping(t *testing.T) {
+	m, err := ReadAbsoluteMapping(strings.NewReader(`{"One Piece": [{"season": 1, "first": 1}, {"season": 21, "first": 892}]}`))
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, AbsoluteMapping{"One Piece": {{Season: 1, First: 1}, {Season: 21, First: 892}}}, m)
+
+	_, err = ReadAbsoluteMapping(strings.NewReader(`{"One Piece": [{"season": 0, "first": 892}]}`))
+	assert.NotNil(t, err)
+}
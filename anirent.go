@@ -3,11 +3,13 @@ package anirent
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
 	"path"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -21,7 +23,11 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Service
@@ -36,10 +42,55 @@ type Service struct {
 	dq      chan downloadRequest // download queue
 	dOnce   sync.Once
 	bus     *event.Bus[*pb.Event]
+
+	providers *ProviderRegistry
+	absolute  AbsoluteMapping
+}
+
+// ServiceOption configures a Service.
+type ServiceOption func(*Service) error
+
+// WithSearchProviders registers the given search providers with the Service.
+// Providers with the same name as a default provider replace it.
+func WithSearchProviders(providers ...SearchProvider) ServiceOption {
+	return func(s *Service) error {
+		for _, p := range providers {
+			s.providers.Register(p)
+		}
+		return nil
+	}
+}
+
+// WithoutSearchProviders disables the named search providers.
+func WithoutSearchProviders(names ...string) ServiceOption {
+	return func(s *Service) error {
+		for _, name := range names {
+			err := s.providers.Disable(name)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// WithTorznab registers a Torznab search provider for the indexer hosted
+// at baseURL.
+func WithTorznab(baseURL, apiKey string) ServiceOption {
+	return WithSearchProviders(Torznab(baseURL, apiKey))
+}
+
+// WithAbsoluteMapping maps the absolute episode numbers of search results
+// onto seasons before they are filtered.
+func WithAbsoluteMapping(m AbsoluteMapping) ServiceOption {
+	return func(s *Service) error {
+		s.absolute = m
+		return nil
+	}
 }
 
 // NewService
-func NewService() (*Service, error) {
+func NewService(opts ...ServiceOption) (*Service, error) {
 	tcfg := torrent.NewDefaultClientConfig()
 	tcfg.ConfigureAnacrolixDhtServer = func(cfg *dht.ServerConfig) {
 		cfg.Logger = log.Default.FilterLevel(log.Error)
@@ -61,10 +112,28 @@ func NewService() (*Service, error) {
 		dataDir: tcfg.DataDir,
 		dq:      make(chan downloadRequest, 1),
 		bus:     event.NewBus[*pb.Event](),
+
+		providers: NewProviderRegistry(
+			Btdig(),
+			Nyaa("https://nyaa.si"),
+		),
+	}
+
+	for _, opt := range opts {
+		err := opt(s)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return s, nil
 }
 
+// Providers returns the registry of search providers used by the Service.
+// It can be used to enable or disable providers while the Service is running.
+func (s *Service) Providers() *ProviderRegistry {
+	return s.providers
+}
+
 // Serve handles the initialization of the underlying gRPC server and registering
 // the Anirent Server service with it. It then begins serving requests. The
 // provided context can be used to gracefully shutdown the server.
@@ -100,47 +169,213 @@ func (s *Service) Serve(ctx context.Context) error {
 
 // Search
 func (s *Service) Search(req *pb.SearchRequest, stream pb.Anirent_SearchServer) error {
-	resultCh := make(chan scrapeResult, 10)
+	if len(s.providers.Enabled()) == 0 {
+		return status.Error(codes.FailedPrecondition, "no search providers are enabled")
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	resultCh := make(chan RawResult, 10)
+	errCh := make(chan error, 1)
 	go func() {
-		err := scrape(context.Background(), req, resultCh)
-		if err != nil {
-			fmt.Println(err)
+		errCh <- s.providers.Search(ctx, req, resultCh)
+	}()
+
+	// Providers only return once they stop sending results so draining
+	// resultCh guarantees none of them outlive this request.
+	defer func() {
+		cancel()
+		for range resultCh {
 		}
 	}()
 
-	for scrapeRes := range resultCh {
-		torrentName := scrapeRes.TorrentName
+	// Results are buffered so duplicates found across resolutions and
+	// providers can be merged, and the results sorted, before any are sent.
+	results, err := collectResults(ctx, req, resultCh, s.absolute)
+	if err != nil {
+		return err
+	}
 
-		zap.L().Debug("parsing scraping result", zap.String("torrent_name", torrentName))
+	sorted := latestRevisions(results.list())
+	sortResults(sorted, req)
 
-		searchResult, err := parser.Parse(torrentName)
+	for _, searchResult := range sorted {
+		err := stream.Send(searchResult)
 		if err != nil {
-			zap.L().Error("unexpected error when parsing torrent name", zap.String("torrent_name", torrentName), zap.Error(err))
-			continue
+			zap.L().Error("unexpected error when sending search result", zap.Error(err))
+			return err
 		}
-		searchResult.Magnet = scrapeRes.Magnet
-
-		var typ string
-		switch searchResult.Details.(type) {
-		case *pb.SearchResult_Episode:
-			typ = "episode"
-		case *pb.SearchResult_Season:
-			typ = "season"
+	}
+
+	err = <-errCh
+	if err == nil {
+		return nil
+	}
+	zap.L().Error("search providers failed", zap.Error(err))
+
+	searchErr, ok := err.(*SearchError)
+	if !ok {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	md := metadata.MD{}
+	for _, failure := range searchErr.Failures {
+		md.Append(ProviderErrorTrailer, fmt.Sprintf("%s: %s: %s", failure.Provider, providerErrorCode(failure.Err), failure.Err))
+	}
+	stream.SetTrailer(md)
+
+	if searchErr.Partial() {
+		return nil
+	}
+	return status.Error(searchErrorCode(searchErr), searchErr.Error())
+}
+
+// collectResults parses every raw result until resultCh is closed, collapsing
+// duplicates along the way. Raw results which can't be parsed, or don't match
+// the request filter, are dropped. Absolute episode numbers are mapped onto
+// seasons first so the filter sees the mapped episodes.
+func collectResults(ctx context.Context, req *pb.SearchRequest, resultCh <-chan RawResult, absolute AbsoluteMapping) (*resultSet, error) {
+	results := newResultSet()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case rawRes, ok := <-resultCh:
+			if !ok {
+				return results, nil
+			}
+			torrentName := rawRes.TorrentName
+
+			zap.L().Debug("parsing raw search result", zap.String("torrent_name", torrentName))
+
+			searchResult, grammar, err := parseTorrentName(rawRes)
+			if err != nil {
+				zap.L().Error("unexpected error when parsing torrent name", zap.String("torrent_name", torrentName), zap.Error(err))
+				continue
+			}
+			applyRawMetadata(searchResult, rawRes)
+
+			if absolute.Apply(searchResult) {
+				zap.L().Debug("mapped absolute episode numbers", zap.String("torrent_name", torrentName))
+			}
+
+			var typ string
+			switch searchResult.Details.(type) {
+			case *pb.SearchResult_Episode:
+				typ = "episode"
+			case *pb.SearchResult_Season:
+				typ = "season"
+			}
+
+			zap.L().Debug(
+				"parsed torrent result",
+				zap.String("name", searchResult.Name),
+				zap.String("resolution", parser.SprintResolution(searchResult.Resolution).String()),
+				zap.String("type", typ),
+				zap.String("grammar", grammar),
+				zap.String("info_hash", searchResult.InfoHash),
+				zap.String("provider", searchResult.Provider),
+				zap.String("name_source", searchResult.NameSource.String()),
+			)
+
+			if !matchesReleaseGroups(searchResult, req) || !matchesFilter(searchResult, req.Filter) {
+				zap.L().Debug("search result filtered out", zap.String("torrent_name", torrentName))
+				continue
+			}
+
+			if results.add(searchResult) {
+				zap.L().Debug("merged duplicate search result", zap.String("torrent_name", torrentName))
+			}
 		}
+	}
+}
 
-		zap.L().Debug(
-			"parsed torrent result",
-			zap.String("name", searchResult.Name),
-			zap.String("resolution", parser.SprintResolution(searchResult.Resolution).String()),
-			zap.String("type", typ),
-		)
+// parseTorrentName parses the torrent name found by a provider. Scraped
+// names are sometimes truncated or escaped differently from the actual
+// torrent name so, if it can't be parsed, the display name of the magnet
+// link is parsed instead. The error for the torrent name is returned if
+// neither can be parsed.
+func parseTorrentName(raw RawResult) (*pb.SearchResult, string, error) {
+	result, grammar, err := parser.Match(raw.TorrentName)
+	if err == nil {
+		result.NameSource = pb.NameSource_TORRENT_NAME
+		return result, grammar, nil
+	}
 
-		err = stream.Send(searchResult)
+	dn, dnErr := parseDisplayName(raw.Magnet)
+	if dnErr != nil || dn == raw.TorrentName {
+		return nil, "", err
+	}
+
+	result, grammar, dnErr = parser.Match(dn)
+	if dnErr != nil {
+		return nil, "", err
+	}
+
+	zap.L().Debug(
+		"parsed magnet display name instead of torrent name",
+		zap.String("torrent_name", raw.TorrentName),
+		zap.String("display_name", dn),
+	)
+	result.NameSource = pb.NameSource_MAGNET_DISPLAY_NAME
+	return result, grammar, nil
+}
+
+// applyRawMetadata copies the metadata a provider found for a torrent onto
+// its parsed search result.
+func applyRawMetadata(result *pb.SearchResult, raw RawResult) {
+	result.Magnet = raw.Magnet
+	result.InfoHash = raw.InfoHash
+	result.Size = raw.Size
+	result.Seeders = raw.Seeders
+	result.Leechers = raw.Leechers
+	result.Provider = raw.Provider
+
+	if result.InfoHash == "" {
+		infoHash, err := parseInfoHash(raw.Magnet)
 		if err != nil {
-			zap.L().Error("unexpected error when sending search result", zap.Error(err))
+			zap.L().Warn("unable to parse infohash from magnet", zap.String("magnet", raw.Magnet), zap.Error(err))
 		}
+		result.InfoHash = infoHash
+	}
+
+	if !raw.PublishedAt.IsZero() {
+		result.UploadedAt = timestamppb.New(raw.PublishedAt)
+	}
+}
+
+// ProviderErrorTrailer is the trailer key under which Search reports each
+// failed search provider as "<provider>: <code>: <error>". Failures are
+// reported even when the search as a whole succeeds.
+const ProviderErrorTrailer = "anirent-provider-error"
+
+// searchErrorCode picks the status code for a search where every provider
+// failed. If the providers disagree Unavailable is used.
+func searchErrorCode(err *SearchError) codes.Code {
+	code := codes.Unavailable
+	for i, failure := range err.Failures {
+		c := providerErrorCode(failure.Err)
+		if i > 0 && c != code {
+			return codes.Unavailable
+		}
+		code = c
+	}
+	return code
+}
+
+func providerErrorCode(err error) codes.Code {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return codes.DeadlineExceeded
+	default:
+		return codes.Unavailable
 	}
-	return nil
 }
 
 type downloadRequest struct {
@@ -241,7 +476,12 @@ func (s *Service) processDownloadRequest(dr downloadRequest) {
 	fileName := files[0].DisplayPath()
 	addr := path.Join("/dns/localhost/tcp/20/file", s.dataDir, fileName)
 	totalBytes := int64(t.Info().TotalLength())
-	s.publishStarted(subId, result.Magnet, totalBytes, addr)
+
+	format := result.Format
+	if format == pb.Format_UNSPECIFIED_FORMAT {
+		format = filesFormat(files)
+	}
+	s.publishStarted(subId, result.Magnet, totalBytes, addr, format)
 
 	t.DisallowDataUpload()
 	t.DownloadAll()
@@ -258,31 +498,87 @@ func (s *Service) processDownloadRequest(dr downloadRequest) {
 		}
 
 		stats := t.Stats()
-		bytesRead := stats.BytesReadData.Int64()
+
+		// Only pieces which have been hash checked and written count as
+		// downloaded. BytesReadData also counts wasted and duplicate chunks
+		// so it can reach the total before the files are complete.
+		completedBytes := t.BytesCompleted()
 
 		zap.L().Info(
 			"stats",
 			zap.Int("active_peers", stats.ActivePeers),
 			zap.Int("total_peers", stats.TotalPeers),
-			zap.Int64("bytes_read", bytesRead),
+			zap.Int64("bytes_read", stats.BytesReadData.Int64()),
+			zap.Int64("bytes_completed", completedBytes),
 		)
 
-		if downloadedBytes == bytesRead {
-			continue
+		if downloadedBytes != completedBytes {
+			downloadedBytes = completedBytes
+			s.publishProgress(subId, result.Magnet, downloadedBytes, totalBytes, addr)
 		}
-		downloadedBytes = bytesRead
-
-		s.publishProgress(subId, result.Magnet, downloadedBytes, totalBytes, addr)
 
-		if downloadedBytes >= totalBytes {
+		if downloadedBytes == totalBytes {
 			break
 		}
 	}
 
-	s.publishDone(subId, result.Magnet, totalBytes, addr)
+	verification, sum := s.verifyDownload(result, files)
+	s.publishDone(subId, result.Magnet, totalBytes, addr, verification, sum)
+}
+
+// filesFormat returns the video format most of the files in a torrent
+// share. Files which aren't videos e.g. subtitles are ignored.
+func filesFormat(files []*torrent.File) pb.Format {
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, f.Path())
+	}
+	return commonFormat(names)
+}
+
+func commonFormat(names []string) pb.Format {
+	counts := make(map[pb.Format]int)
+	format := pb.Format_UNSPECIFIED_FORMAT
+	for _, name := range names {
+		f := parser.FileFormat(name)
+		if f == pb.Format_UNSPECIFIED_FORMAT {
+			continue
+		}
+
+		counts[f]++
+		if counts[f] > counts[format] {
+			format = f
+		}
+	}
+	return format
+}
+
+// verifyDownload checks a downloaded episode against its CRC32 label.
+// Releases without a label, and torrents with more than one file, are left
+// unverified.
+func (s *Service) verifyDownload(result *pb.SearchResult, files []*torrent.File) (pb.Verification, string) {
+	if result.Crc32 == "" || len(files) != 1 {
+		return pb.Verification_UNVERIFIED, ""
+	}
+
+	filePath := filepath.Join(s.dataDir, files[0].Path())
+	verification, sum, err := verifyCRC32(filePath, result.Crc32)
+	if err != nil {
+		zap.L().Error("unexpected error when verifying download", zap.String("magnet", result.Magnet), zap.Error(err))
+		return pb.Verification_UNVERIFIED, ""
+	}
+	if verification == pb.Verification_MISMATCH {
+		zap.L().Warn(
+			"downloaded file does not match its crc32 label",
+			zap.String("magnet", result.Magnet),
+			zap.String("expected", result.Crc32),
+			zap.String("actual", sum),
+		)
+	}
+	return verification, sum
 }
 
-func (s *Service) publishStarted(subId, magnet string, total int64, addr string) {
+func (s *Service) publishStarted(subId, magnet string, total int64, addr string, format pb.Format) {
 	s.bus.Publish(subId, &pb.Event{
 		Id:             uuid.Must(uuid.NewRandomFromReader(s.rander)).String(),
 		SubscriptionId: subId,
@@ -291,6 +587,7 @@ func (s *Service) publishStarted(subId, magnet string, total int64, addr string)
 				Magnet:     magnet,
 				TotalBytes: total,
 				MultiAddr:  addr,
+				Format:     format,
 			},
 		},
 	})
@@ -311,15 +608,17 @@ func (s *Service) publishProgress(subId, magnet string, downloaded, total int64,
 	})
 }
 
-func (s *Service) publishDone(subId, magnet string, total int64, multiAddr string) {
+func (s *Service) publishDone(subId, magnet string, total int64, multiAddr string, verification pb.Verification, sum string) {
 	s.bus.Publish(subId, &pb.Event{
 		Id:             uuid.Must(uuid.NewRandomFromReader(s.rander)).String(),
 		SubscriptionId: subId,
 		Payload: &pb.Event_Completed{
 			Completed: &pb.DownloadComplete{
-				Magnet:     magnet,
-				TotalBytes: total,
-				MultiAddr:  multiAddr,
+				Magnet:       magnet,
+				TotalBytes:   total,
+				MultiAddr:    multiAddr,
+				Verification: verification,
+				Crc32:        sum,
 			},
 		},
 	})
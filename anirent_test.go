@@ -0,0 +1,336 @@
+package anirent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type searchStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	send    func(*pb.SearchResult) error
+	trailer metadata.MD
+}
+
+func (s *searchStream) SetTrailer(md metadata.MD) {
+	s.trailer = metadata.Join(s.trailer, md)
+}
+
+func (s *searchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *searchStream) Send(result *pb.SearchResult) error {
+	return s.send(result)
+}
+
+// endlessProvider keeps sending the same result until ctx is cancelled.
+type endlessProvider struct {
+	started chan struct{}
+	done    chan struct{}
+}
+
+func (endlessProvider) Name() string {
+	return "endless"
+}
+
+func (p endlessProvider) Search(ctx context.Context, req *pb.SearchRequest, resultCh chan<- RawResult) error {
+	defer close(p.done)
+	close(p.started)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case resultCh <- RawResult{TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv"}:
+		}
+	}
+}
+
+// stoppingProvider closes done once the wrapped provider returns.
+type stoppingProvider struct {
+	SearchProvider
+	done chan struct{}
+}
+
+func (p stoppingProvider) Search(ctx context.Context, req *pb.SearchRequest, resultCh chan<- RawResult) error {
+	defer close(p.done)
+	return p.SearchProvider.Search(ctx, req, resultCh)
+}
+
+func TestSearchStopsProvidersWhenSendFails(t *testing.T) {
+	p := stoppingProvider{
+		SearchProvider: staticProvider{
+			name: "static",
+			results: []RawResult{
+				{TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv"},
+				{TorrentName: "[SubsPlease] Tonikaku Kawaii - 09 (1080p) [A1B2C3D4].mkv"},
+			},
+		},
+		done: make(chan struct{}),
+	}
+	s := &Service{providers: NewProviderRegistry(p)}
+
+	sendErr := errors.New("client went away")
+	err := s.Search(&pb.SearchRequest{}, &searchStream{
+		ctx: context.Background(),
+		send: func(*pb.SearchResult) error {
+			return sendErr
+		},
+	})
+	assert.Equal(t, sendErr, err)
+
+	// No provider may outlive Search so it must already have returned.
+	select {
+	case <-p.done:
+	default:
+		t.Error("provider outlived search")
+	}
+}
+
+func TestSearchStopsProvidersWhenClientCancels(t *testing.T) {
+	p := endlessProvider{
+		started: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	s := &Service{providers: NewProviderRegistry(p)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-p.started
+		cancel()
+	}()
+
+	err := s.Search(&pb.SearchRequest{}, &searchStream{
+		ctx: ctx,
+		send: func(*pb.SearchResult) error {
+			return nil
+		},
+	})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	select {
+	case <-p.done:
+	case <-time.After(time.Second):
+		t.Error("provider was not stopped")
+	}
+}
+
+func TestSearchReportsProviderFailures(t *testing.T) {
+	ok := staticProvider{
+		name:    "ok",
+		results: []RawResult{{TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv"}},
+	}
+	down := staticProvider{name: "down", err: errors.New("Service Unavailable")}
+	slow := staticProvider{name: "slow", err: context.DeadlineExceeded}
+
+	testCases := []struct {
+		Name      string
+		Providers []SearchProvider
+		Code      codes.Code
+		Sent      int
+		Trailer   []string
+	}{
+		{
+			Name:      "No Failures",
+			Providers: []SearchProvider{ok},
+			Code:      codes.OK,
+			Sent:      1,
+		},
+		{
+			Name:      "Partial Failure",
+			Providers: []SearchProvider{ok, down},
+			Code:      codes.OK,
+			Sent:      1,
+			Trailer:   []string{"down: Unavailable: Service Unavailable"},
+		},
+		{
+			Name:      "Total Failure",
+			Providers: []SearchProvider{down},
+			Code:      codes.Unavailable,
+			Trailer:   []string{"down: Unavailable: Service Unavailable"},
+		},
+		{
+			Name:      "Deadline Exceeded",
+			Providers: []SearchProvider{slow},
+			Code:      codes.DeadlineExceeded,
+			Trailer:   []string{"slow: DeadlineExceeded: context deadline exceeded"},
+		},
+		{
+			Name:      "Mixed Failures",
+			Providers: []SearchProvider{down, slow},
+			Code:      codes.Unavailable,
+			Trailer: []string{
+				"down: Unavailable: Service Unavailable",
+				"slow: DeadlineExceeded: context deadline exceeded",
+			},
+		},
+		{
+			Name: "No Providers",
+			Code: codes.FailedPrecondition,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			s := &Service{providers: NewProviderRegistry(testCase.Providers...)}
+
+			sent := 0
+			stream := &searchStream{
+				ctx: context.Background(),
+				send: func(*pb.SearchResult) error {
+					sent += 1
+					return nil
+				},
+			}
+			err := s.Search(&pb.SearchRequest{}, stream)
+
+			assert.Equal(subT, testCase.Code, status.Code(err))
+			assert.Equal(subT, testCase.Sent, sent)
+			assert.Equal(subT, testCase.Trailer, stream.trailer.Get(ProviderErrorTrailer))
+		})
+	}
+}
+
+func TestSearchAppliesProviderMetadata(t *testing.T) {
+	uploadedAt := time.Date(2020, time.November, 21, 16, 31, 26, 0, time.UTC)
+	p := staticProvider{
+		name: "static",
+		results: []RawResult{
+			{
+				TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv",
+				Magnet:      "magnet:?xt=urn:btih:8E0A5A0E1C2E3B4D5F60718293A4B5C6D7E8F901",
+				Size:        1503238553,
+				Seeders:     512,
+				Leechers:    3,
+				PublishedAt: uploadedAt,
+			},
+		},
+	}
+	s := &Service{providers: NewProviderRegistry(p)}
+
+	var results []*pb.SearchResult
+	err := s.Search(&pb.SearchRequest{}, &searchStream{
+		ctx: context.Background(),
+		send: func(result *pb.SearchResult) error {
+			results = append(results, result)
+			return nil
+		},
+	})
+	if !assert.Nil(t, err) || !assert.Len(t, results, 1) {
+		return
+	}
+
+	result := results[0]
+	assert.Equal(t, "8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901", result.InfoHash)
+	assert.Equal(t, int64(1503238553), result.Size)
+	assert.Equal(t, int64(512), result.Seeders)
+	assert.Equal(t, int64(3), result.Leechers)
+	assert.Equal(t, uploadedAt, result.UploadedAt.AsTime())
+	assert.Equal(t, "SubsPlease", result.ReleaseGroup)
+	assert.Equal(t, "static", result.Provider)
+}
+
+func TestCommonFormat(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Files    []string
+		Expected pb.Format
+	}{
+		{
+			Name:     "No Files",
+			Expected: pb.Format_UNSPECIFIED_FORMAT,
+		},
+		{
+			Name: "Batch With Subtitles",
+			Files: []string{
+				"Tonikaku Kawaii/[SubsPlease] Tonikaku Kawaii - 01 (1080p) [37FBE4D6].mp4",
+				"Tonikaku Kawaii/[SubsPlease] Tonikaku Kawaii - 01 (1080p) [37FBE4D6].ass",
+				"Tonikaku Kawaii/[SubsPlease] Tonikaku Kawaii - 02 (1080p) [2CE8E7DE].mp4",
+			},
+			Expected: pb.Format_MP4,
+		},
+		{
+			Name: "Mostly One Format",
+			Files: []string{
+				"01.mkv",
+				"02.mkv",
+				"NCOP.webm",
+			},
+			Expected: pb.Format_MKV,
+		},
+		{
+			Name:     "No Videos",
+			Files:    []string{"readme.txt"},
+			Expected: pb.Format_UNSPECIFIED_FORMAT,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			assert.Equal(subT, testCase.Expected, commonFormat(testCase.Files))
+		})
+	}
+}
+
+func TestParseTorrentName(t *testing.T) {
+	magnet := buildMagnet("8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901", "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv")
+
+	testCases := []struct {
+		Name       string
+		Raw        RawResult
+		NameSource pb.NameSource
+		Err        bool
+	}{
+		{
+			Name: "Torrent Name",
+			Raw: RawResult{
+				TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv",
+				Magnet:      magnet,
+			},
+			NameSource: pb.NameSource_TORRENT_NAME,
+		},
+		{
+			Name: "Truncated Torrent Name",
+			Raw: RawResult{
+				TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FB...",
+				Magnet:      magnet,
+			},
+			NameSource: pb.NameSource_MAGNET_DISPLAY_NAME,
+		},
+		{
+			Name: "No Display Name",
+			Raw: RawResult{
+				TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FB...",
+				Magnet:      "magnet:?xt=urn:btih:8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901",
+			},
+			Err: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			result, _, err := parseTorrentName(testCase.Raw)
+			if testCase.Err {
+				assert.NotNil(subT, err)
+				return
+			}
+			if !assert.Nil(subT, err) {
+				return
+			}
+			assert.Equal(subT, "Tonikaku Kawaii", result.Name)
+			assert.Equal(subT, testCase.NameSource, result.NameSource)
+		})
+	}
+}
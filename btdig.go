@@ -0,0 +1,139 @@
+package anirent
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Zaba505/anirent/parser"
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/gocolly/colly/v2"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+type btdig struct {
+	baseURL string
+}
+
+// Btdig returns a SearchProvider which scrapes the btdig.com search engine.
+func Btdig() SearchProvider {
+	return btdig{
+		baseURL: "https://btdig.com",
+	}
+}
+
+func (btdig) Name() string {
+	return "btdig"
+}
+
+// btdigMaxPages is the number of pages fetched when a request doesn't
+// specify max_pages.
+const btdigMaxPages = 10
+
+func (b btdig) Search(ctx context.Context, req *pb.SearchRequest, resultCh chan<- RawResult) error {
+	maxPages := req.MaxPages
+	if maxPages <= 0 {
+		maxPages = btdigMaxPages
+	}
+	limit := newResultLimit(req)
+
+	g, ctx := errgroup.WithContext(ctx)
+	for _, group := range releaseGroups(req) {
+		for _, resolution := range req.Resolutions {
+			res := parser.SprintResolution(resolution) // TODO: map resolution to correct string orientation
+			q := fmt.Sprintf("[%s] %s (%s)", group, req.AnimeName, res)
+
+			g.Go(func() error {
+				return b.searchPages(ctx, q, maxPages, limit, resultCh)
+			})
+		}
+	}
+
+	return g.Wait()
+}
+
+// searchPages follows the result pages for the query until an empty page,
+// maxPages or the result limit is reached.
+func (b btdig) searchPages(ctx context.Context, q string, maxPages int64, limit *resultLimit, resultCh chan<- RawResult) error {
+	found := 0
+
+	c := newCollector(ctx)
+	c.OnHTML("div[class='one_result']", func(el *colly.HTMLElement) {
+		found += 1
+		if !limit.take() {
+			return
+		}
+
+		result := RawResult{
+			TorrentName: el.ChildText("div[class='torrent_name']"),
+			Magnet:      el.ChildAttr("div[class='torrent_magnet'] a", "href"),
+		}
+
+		// btdig has no seeder counts and only shows relative ages
+		// so the size is the only extra metadata worth scraping.
+		size, err := parseSize(el.ChildText(".torrent_size"))
+		if err == nil {
+			result.Size = size
+		}
+
+		zap.L().Debug("found search result", zap.String("torrent_name", result.TorrentName), zap.String("magnet", result.Magnet))
+		select {
+		case <-ctx.Done():
+		case resultCh <- result:
+		}
+	})
+
+	for page := int64(0); page < maxPages; page++ {
+		uri := fmt.Sprintf("%s/search?q=%s&p=%d&order=0", b.baseURL, q, page)
+		uri = strings.ReplaceAll(uri, " ", "+")
+
+		found = 0
+		err := c.Visit(uri)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			return err
+		}
+
+		// An empty page means every result has been seen.
+		if found == 0 || limit.reached() {
+			return nil
+		}
+	}
+	return nil
+}
+
+// newCollector returns a collector whose requests are aborted, or never sent,
+// once ctx is cancelled since colly has no native support for contexts.
+func newCollector(ctx context.Context) *colly.Collector {
+	c := colly.NewCollector(
+		colly.UserAgent("anirent-scraper"),
+	)
+	c.Limit(&colly.LimitRule{
+		Parallelism: 1,
+	})
+	c.WithTransport(contextTransport{
+		ctx: ctx,
+		rt:  http.DefaultTransport,
+	})
+
+	c.OnRequest(func(r *colly.Request) {
+		if ctx.Err() != nil {
+			r.Abort()
+		}
+	})
+	return c
+}
+
+type contextTransport struct {
+	ctx context.Context
+	rt  http.RoundTripper
+}
+
+func (t contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.rt.RoundTrip(req.WithContext(t.ctx))
+}
@@ -0,0 +1,97 @@
+package anirent
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newBtdigServer serves pages of 10 results until total results have been
+// served and records every page requested.
+func newBtdigServer(total int, pages *[]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("p"))
+		*pages = append(*pages, page)
+
+		var b strings.Builder
+		b.WriteString("<html><body>")
+		for i := page * 10; i < (page+1)*10 && i < total; i++ {
+			fmt.Fprintf(&b, `<div class="one_result">
+	<div class="torrent_name">[SubsPlease] Tonikaku Kawaii - %02d (1080p) [37FBE4D6].mkv</div>
+	<div class="torrent_magnet"><a href="magnet:?xt=urn:btih:%040d">magnet</a></div>
+	<div class="torrent_info"><span class="torrent_size">1.40 GB</span> <span class="torrent_age">found 2 years ago</span></div>
+</div>`, i+1, i+1)
+		}
+		b.WriteString("</body></html>")
+
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(b.String()))
+	}))
+}
+
+func TestBtdigPagination(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Total      int
+		MaxPages   int64
+		MaxResults int64
+		Pages      []int
+		Results    int
+	}{
+		{
+			Name:    "Stops At Empty Page",
+			Total:   25,
+			Pages:   []int{0, 1, 2, 3},
+			Results: 25,
+		},
+		{
+			Name:     "Stops At Max Pages",
+			Total:    25,
+			MaxPages: 2,
+			Pages:    []int{0, 1},
+			Results:  20,
+		},
+		{
+			Name:       "Stops At Max Results",
+			Total:      25,
+			MaxResults: 15,
+			Pages:      []int{0, 1},
+			Results:    15,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			var pages []int
+			srv := newBtdigServer(testCase.Total, &pages)
+			defer srv.Close()
+
+			resultCh := make(chan RawResult, 100)
+			err := btdig{baseURL: srv.URL}.Search(context.Background(), &pb.SearchRequest{
+				AnimeName:   "Tonikaku Kawaii",
+				Resolutions: []pb.Resolution{pb.Resolution_P_1080},
+				MaxPages:    testCase.MaxPages,
+				MaxResults:  testCase.MaxResults,
+			}, resultCh)
+			close(resultCh)
+			if !assert.Nil(subT, err) {
+				return
+			}
+
+			assert.Equal(subT, testCase.Pages, pages)
+
+			results := collect(resultCh)
+			if assert.Len(subT, results, testCase.Results) {
+				assert.Equal(subT, int64(1400000000), results[0].Size)
+			}
+		})
+	}
+}
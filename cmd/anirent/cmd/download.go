@@ -92,8 +92,15 @@ var downloadCmd = &cobra.Command{
 					"download started",
 					zap.String("magnet", started.Magnet),
 					zap.Int64("total", started.TotalBytes),
+					zap.String("format", started.Format.String()),
 				)
 
+				// Batches only learn their format once the torrent's
+				// files are known.
+				if result.Format == pb.Format_UNSPECIFIED_FORMAT {
+					result.Format = started.Format
+				}
+
 				bar.ChangeMax64(started.TotalBytes)
 				bar.Describe(started.MultiAddr)
 			case *pb.Event_Progress:
@@ -116,9 +123,21 @@ var downloadCmd = &cobra.Command{
 					zap.String("magnet", done.Magnet),
 					zap.Int64("total", done.TotalBytes),
 					zap.String("multi_addr", done.MultiAddr),
+					zap.String("verification", done.Verification.String()),
 				)
 				bar.Close()
 
+				// Keep corrupt or swapped files out of the library.
+				if done.Verification == pb.Verification_MISMATCH {
+					zap.L().Error(
+						"downloaded file does not match its crc32 label",
+						zap.String("expected", result.Crc32),
+						zap.String("actual", done.Crc32),
+						zap.String("multi_addr", done.MultiAddr),
+					)
+					return
+				}
+
 				dir, err := cmd.Flags().GetString("dir")
 				if err != nil {
 					panic(err)
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Zaba505/anirent/parser"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var parseCmd = &cobra.Command{
+	Use:   "parse [TORRENT_NAME...]",
+	Short: "Parse torrent names into search results",
+	Long: `Parse torrent names into search results, reading names line by line
+from stdin if none are given. Names which can't be parsed are reported
+with the reason why.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		explain, err := cmd.Flags().GetBool("explain")
+		if err != nil {
+			panic(err)
+		}
+
+		names := args
+		if len(names) == 0 {
+			names, err = readLines(cmd.InOrStdin())
+			if err != nil {
+				zap.L().Error("unexpected error when reading torrent names", zap.Error(err))
+				return
+			}
+		}
+
+		failed := false
+		for _, name := range names {
+			err := printParsed(cmd.OutOrStdout(), cmd.ErrOrStderr(), name, explain)
+			if err != nil {
+				failed = true
+			}
+		}
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
+
+// printParsed writes the search result parsed from name to w, or why it
+// couldn't be parsed to errW.
+func printParsed(w, errW io.Writer, name string, explain bool) error {
+	result, grammar, err := parser.Match(name)
+	if err != nil {
+		parseErr, ok := err.(*parser.ParseError)
+		if explain && ok {
+			fmt.Fprintf(errW, "%s\n\n", parseErr.Explain())
+			return err
+		}
+
+		fmt.Fprintf(errW, "%s: %s\n", name, err)
+		return err
+	}
+
+	zap.L().Debug("parsed torrent name", zap.String("torrent_name", name), zap.String("grammar", grammar))
+
+	b, err := protojson.Marshal(result)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+func readLines(r io.Reader) ([]string, error) {
+	var lines []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if line := s.Text(); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, s.Err()
+}
+
+func init() {
+	rootCmd.AddCommand(parseCmd)
+
+	parseCmd.Flags().Bool("explain", false, "Render a caret under the position where unparseable names failed")
+}
@@ -3,7 +3,11 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/Zaba505/anirent"
 	"github.com/Zaba505/anirent/parser"
@@ -14,6 +18,8 @@ import (
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -50,7 +56,42 @@ var subspleaseCmd = &cobra.Command{
 		res := cmd.Flags().Lookup("resolution").Value.String()
 		resolution := flagResToProtoRes[parser.Resolution(res)]
 
-		s, err := anirent.NewService()
+		var opts []anirent.ServiceOption
+
+		torznabURL, err := cmd.Flags().GetString("torznab-url")
+		if err != nil {
+			panic(err)
+		}
+		if torznabURL != "" {
+			apiKey, err := cmd.Flags().GetString("torznab-api-key")
+			if err != nil {
+				panic(err)
+			}
+
+			opts = append(opts, anirent.WithTorznab(torznabURL, apiKey))
+		}
+
+		disabled, err := cmd.Flags().GetStringSlice("disable-provider")
+		if err != nil {
+			panic(err)
+		}
+		opts = append(opts, anirent.WithoutSearchProviders(disabled...))
+
+		absolutePath, err := cmd.Flags().GetString("absolute-map")
+		if err != nil {
+			panic(err)
+		}
+		if absolutePath != "" {
+			m, err := readAbsoluteMapping(absolutePath)
+			if err != nil {
+				zap.L().Error("unexpected error when reading absolute mapping", zap.Error(err))
+				return
+			}
+
+			opts = append(opts, anirent.WithAbsoluteMapping(m))
+		}
+
+		s, err := anirent.NewService(opts...)
 		if err != nil {
 			zap.L().Error("unexpected error when creating anirent service", zap.Error(err))
 			return
@@ -71,9 +112,44 @@ var subspleaseCmd = &cobra.Command{
 		}
 		client := pb.NewAnirentClient(cc)
 
+		maxPages, err := cmd.Flags().GetInt64("max-pages")
+		if err != nil {
+			panic(err)
+		}
+		maxResults, err := cmd.Flags().GetInt64("max-results")
+		if err != nil {
+			panic(err)
+		}
+
+		sortFlags, err := cmd.Flags().GetStringSlice("sort")
+		if err != nil {
+			panic(err)
+		}
+		sortOpts, err := parseSortOptions(sortFlags)
+		if err != nil {
+			zap.L().Error("invalid sort option", zap.Error(err))
+			return
+		}
+
+		filter, err := parseSearchFilter(cmd)
+		if err != nil {
+			zap.L().Error("invalid search filter", zap.Error(err))
+			return
+		}
+
+		groups, err := cmd.Flags().GetStringSlice("group")
+		if err != nil {
+			panic(err)
+		}
+
 		stream, err := client.Search(ctx, &pb.SearchRequest{
-			AnimeName:   args[0],
-			Resolutions: []pb.Resolution{resolution},
+			AnimeName:     args[0],
+			ReleaseGroups: groups,
+			Resolutions:   []pb.Resolution{resolution},
+			MaxPages:      maxPages,
+			MaxResults:    maxResults,
+			Sort:          sortOpts,
+			Filter:        filter,
 		})
 		if err != nil {
 			zap.L().Error("unexpected error when sending search request", zap.Error(err))
@@ -85,10 +161,18 @@ var subspleaseCmd = &cobra.Command{
 			result, err := stream.Recv()
 			if err == io.EOF {
 				zap.L().Info("no more search results")
+				logProviderErrors(stream.Trailer())
 				break
 			}
 			if err != nil {
-				zap.L().Error("unexpected error when receiving search results", zap.Error(err))
+				logProviderErrors(stream.Trailer())
+
+				st := status.Convert(err)
+				zap.L().Error(
+					"search failed",
+					zap.String("code", st.Code().String()),
+					zap.String("message", st.Message()),
+				)
 				return
 			}
 
@@ -105,6 +189,8 @@ var subspleaseCmd = &cobra.Command{
 				zap.String("resolution", parser.SprintResolution(result.Resolution).String()),
 				zap.String("type", typ),
 				zap.String("magnet", result.Magnet),
+				zap.Int64("seeders", result.Seeders),
+				zap.String("provider", result.Provider),
 			)
 
 			results = append(results, result)
@@ -117,6 +203,162 @@ var subspleaseCmd = &cobra.Command{
 	},
 }
 
+var sortKeys = map[string]pb.SortKey{
+	"episode":    pb.SortKey_EPISODE_NUMBER,
+	"seeders":    pb.SortKey_SEEDERS,
+	"size":       pb.SortKey_SIZE,
+	"uploaded":   pb.SortKey_UPLOAD_DATE,
+	"resolution": pb.SortKey_RESOLUTION_PREFERENCE,
+	"source":     pb.SortKey_SOURCE,
+}
+
+// parseSortOptions parses sort keys, optionally prefixed with '-' to sort
+// in descending order e.g. -seeders
+func parseSortOptions(ss []string) ([]*pb.SortOption, error) {
+	opts := make([]*pb.SortOption, 0, len(ss))
+	for _, s := range ss {
+		descending := strings.HasPrefix(s, "-")
+		name := strings.TrimPrefix(s, "-")
+
+		key, ok := sortKeys[name]
+		if !ok {
+			return nil, fmt.Errorf("subsplease: unsupported sort key - %s", name)
+		}
+
+		opts = append(opts, &pb.SortOption{
+			Key:        key,
+			Descending: descending,
+		})
+	}
+	return opts, nil
+}
+
+var releaseTypes = map[string]pb.ReleaseType{
+	"any":     pb.ReleaseType_ANY_RELEASE,
+	"episode": pb.ReleaseType_EPISODES_ONLY,
+	"batch":   pb.ReleaseType_BATCHES_ONLY,
+}
+
+var videoCodecs = map[string]pb.VideoCodec{
+	"avc":  pb.VideoCodec_AVC,
+	"hevc": pb.VideoCodec_HEVC,
+	"av1":  pb.VideoCodec_AV1,
+}
+
+var sources = map[string]pb.Source{
+	"tv":  pb.Source_TV,
+	"dvd": pb.Source_DVD,
+	"web": pb.Source_WEB,
+	"bd":  pb.Source_BLURAY,
+}
+
+func parseSearchFilter(cmd *cobra.Command) (*pb.SearchFilter, error) {
+	var filter pb.SearchFilter
+
+	episodes, err := cmd.Flags().GetString("episodes")
+	if err != nil {
+		panic(err)
+	}
+	if episodes != "" {
+		filter.FirstEpisode, filter.LastEpisode, err = parseEpisodeRange(episodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filter.Season, err = cmd.Flags().GetInt64("season")
+	if err != nil {
+		panic(err)
+	}
+
+	filter.MinVersion, err = cmd.Flags().GetInt64("min-version")
+	if err != nil {
+		panic(err)
+	}
+
+	typ, err := cmd.Flags().GetString("type")
+	if err != nil {
+		panic(err)
+	}
+	releaseType, ok := releaseTypes[typ]
+	if !ok {
+		return nil, fmt.Errorf("subsplease: unsupported release type - %s", typ)
+	}
+	filter.ReleaseType = releaseType
+
+	excludedCodecs, err := cmd.Flags().GetStringSlice("exclude-codec")
+	if err != nil {
+		panic(err)
+	}
+	for _, name := range excludedCodecs {
+		codec, ok := videoCodecs[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("subsplease: unsupported video codec - %s", name)
+		}
+		filter.ExcludeVideoCodecs = append(filter.ExcludeVideoCodecs, codec)
+	}
+
+	sourceNames, err := cmd.Flags().GetStringSlice("source")
+	if err != nil {
+		panic(err)
+	}
+	for _, name := range sourceNames {
+		source, ok := sources[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("subsplease: unsupported source - %s", name)
+		}
+		filter.Sources = append(filter.Sources, source)
+	}
+
+	return &filter, nil
+}
+
+// parseEpisodeRange parses a single episode e.g. 5 or an inclusive range of
+// episodes e.g. 13-24 where either bound may be omitted e.g. 13-
+func parseEpisodeRange(s string) (int64, int64, error) {
+	first, last, isRange := strings.Cut(s, "-")
+	if !isRange {
+		last = first
+	}
+
+	var bounds [2]int64
+	for i, bound := range []string{first, last} {
+		if bound == "" {
+			continue
+		}
+
+		n, err := strconv.ParseInt(bound, 10, 64)
+		if err != nil || n < 1 {
+			return 0, 0, fmt.Errorf("subsplease: invalid episode range - %s", s)
+		}
+		bounds[i] = n
+	}
+	if bounds[1] != 0 && bounds[0] > bounds[1] {
+		return 0, 0, fmt.Errorf("subsplease: invalid episode range - %s", s)
+	}
+	return bounds[0], bounds[1], nil
+}
+
+// readAbsoluteMapping reads the season offsets for absolutely numbered
+// anime from the JSON file at path.
+func readAbsoluteMapping(path string) (anirent.AbsoluteMapping, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return anirent.ReadAbsoluteMapping(f)
+}
+
+// logProviderErrors logs every search provider which failed, as reported
+// by the Search trailer.
+func logProviderErrors(md metadata.MD) {
+	for _, failure := range md.Get(anirent.ProviderErrorTrailer) {
+		zap.L().Warn("search provider failed", zap.String("failure", failure))
+	}
+}
+
 func writeSearchResults(w io.Writer, results []*pb.SearchResult) error {
 	if len(results) == 0 {
 		_, err := w.Write([]byte("[]"))
@@ -147,4 +389,18 @@ func init() {
 
 	res := parser.RESOLUTION_1080P
 	subspleaseCmd.Flags().VarP(&res, "resolution", "r", "Specify desired resolution")
+	subspleaseCmd.Flags().Int64("max-pages", 0, "Maximum number of result pages to fetch from each provider (0 uses the provider default)")
+	subspleaseCmd.Flags().Int64("max-results", 0, "Maximum number of results to return from each provider (0 means no limit)")
+	subspleaseCmd.Flags().StringSlice("group", []string{anirent.DefaultReleaseGroup}, "Release groups to search for e.g. SubsPlease, Erai-raws")
+	subspleaseCmd.Flags().String("episodes", "", "Only include episodes in this range e.g. 5, 13-24 or 13-")
+	subspleaseCmd.Flags().Int64("season", 0, "Only include results from this season")
+	subspleaseCmd.Flags().String("type", "any", "Only include releases of this type: any, episode or batch")
+	subspleaseCmd.Flags().Int64("min-version", 0, "Only include releases with at least this version e.g. 2 for revised releases")
+	subspleaseCmd.Flags().StringSlice("exclude-codec", nil, "Exclude releases encoded with these video codecs: avc, hevc or av1")
+	subspleaseCmd.Flags().StringSlice("source", nil, "Only include releases from these sources: tv, dvd, web or bd")
+	subspleaseCmd.Flags().StringSlice("sort", []string{"episode"}, "Sort results by episode, seeders, size, uploaded, resolution or source. Prefix with '-' for descending order")
+	subspleaseCmd.Flags().StringSlice("disable-provider", nil, "Disable search providers by name e.g. btdig, nyaa")
+	subspleaseCmd.Flags().String("torznab-url", "", "Base URL of a Torznab indexer e.g. http://localhost:9117/api/v2.0/indexers/all/results/torznab")
+	subspleaseCmd.Flags().String("torznab-api-key", "", "API key for the Torznab indexer")
+	subspleaseCmd.Flags().String("absolute-map", "", "JSON file of season offsets used to map absolute episode numbers onto seasons e.g. {\"One Piece\": [{\"season\": 21, \"first\": 892}]}")
 }
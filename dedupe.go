@@ -0,0 +1,98 @@
+package anirent
+
+import (
+	pb "github.com/Zaba505/anirent/proto"
+)
+
+// resultSet collapses duplicate search results, identified by infohash or
+// falling back to their CRC32 label, while preserving the order in which
+// each torrent was first found.
+type resultSet struct {
+	index   map[string]int
+	results []*pb.SearchResult
+}
+
+func newResultSet() *resultSet {
+	return &resultSet{
+		index: make(map[string]int),
+	}
+}
+
+func dedupeKeys(result *pb.SearchResult) []string {
+	var keys []string
+	if result.InfoHash != "" {
+		keys = append(keys, "btih:"+result.InfoHash)
+	}
+	if result.Crc32 != "" {
+		keys = append(keys, "crc:"+result.Crc32)
+	}
+	return keys
+}
+
+// add inserts the result into the set, merging it into an earlier result
+// for the same torrent if there is one. It reports whether the result was
+// a duplicate.
+func (s *resultSet) add(result *pb.SearchResult) bool {
+	keys := dedupeKeys(result)
+
+	i, exists := -1, false
+	for _, key := range keys {
+		i, exists = s.index[key]
+		if exists {
+			break
+		}
+	}
+
+	if exists {
+		mergeResults(s.results[i], result)
+	} else {
+		i = len(s.results)
+		s.results = append(s.results, result)
+	}
+
+	// A duplicate may have been found by a key the original lacked e.g.
+	// the original had no infohash so remember every key.
+	for _, key := range keys {
+		s.index[key] = i
+	}
+	return exists
+}
+
+// list returns the unique results in the order they were first added.
+func (s *resultSet) list() []*pb.SearchResult {
+	return s.results
+}
+
+// mergeResults fills in metadata dst is missing from src. Peer counts are
+// snapshots which may be stale so the largest is kept.
+func mergeResults(dst, src *pb.SearchResult) {
+	if dst.Magnet == "" {
+		dst.Magnet = src.Magnet
+	}
+	if dst.InfoHash == "" {
+		dst.InfoHash = src.InfoHash
+	}
+	if dst.Size == 0 {
+		dst.Size = src.Size
+	}
+	if dst.ReleaseGroup == "" {
+		dst.ReleaseGroup = src.ReleaseGroup
+	}
+	if dst.Crc32 == "" {
+		dst.Crc32 = src.Crc32
+	}
+	if src.Seeders > dst.Seeders {
+		dst.Seeders = src.Seeders
+	}
+	if src.Leechers > dst.Leechers {
+		dst.Leechers = src.Leechers
+	}
+
+	// Keep the earliest known upload time since mirrors and aggregators
+	// often re-list torrents well after they were first uploaded.
+	switch {
+	case src.UploadedAt == nil:
+	case dst.UploadedAt == nil || src.UploadedAt.AsTime().Before(dst.UploadedAt.AsTime()):
+		dst.UploadedAt = src.UploadedAt
+	}
+}
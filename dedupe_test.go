@@ -0,0 +1,71 @@
+package anirent
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestResultSet(t *testing.T) {
+	earlier := time.Date(2020, time.November, 21, 16, 31, 26, 0, time.UTC)
+	later := earlier.Add(24 * time.Hour)
+
+	s := newResultSet()
+
+	// first seen without an infohash, e.g. btdig magnet couldn't be parsed
+	dup := s.add(&pb.SearchResult{
+		Name:     "Tonikaku Kawaii",
+		Provider: "btdig",
+		Size:     1400000000,
+		Crc32:    "37FBE4D6",
+	})
+	assert.False(t, dup)
+
+	// matched by CRC label
+	dup = s.add(&pb.SearchResult{
+		Name:       "Tonikaku Kawaii",
+		Provider:   "nyaa",
+		InfoHash:   "8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901",
+		Size:       1503238553,
+		Seeders:    512,
+		Leechers:   3,
+		UploadedAt: timestamppb.New(later),
+		Crc32:      "37FBE4D6",
+	})
+	assert.True(t, dup)
+
+	// matched by infohash learnt from the previous duplicate
+	dup = s.add(&pb.SearchResult{
+		Name:       "Tonikaku Kawaii",
+		Provider:   "torznab",
+		InfoHash:   "8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901",
+		Seeders:    100,
+		Leechers:   7,
+		UploadedAt: timestamppb.New(earlier),
+	})
+	assert.True(t, dup)
+
+	// unrelated torrent
+	dup = s.add(&pb.SearchResult{
+		Name:     "Tonikaku Kawaii",
+		InfoHash: "0123456789abcdef0123456789abcdef01234567",
+	})
+	assert.False(t, dup)
+
+	results := s.list()
+	if !assert.Len(t, results, 2) {
+		return
+	}
+
+	merged := results[0]
+	assert.Equal(t, "btdig", merged.Provider)
+	assert.Equal(t, "8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901", merged.InfoHash)
+	assert.Equal(t, int64(1400000000), merged.Size)
+	assert.Equal(t, int64(512), merged.Seeders)
+	assert.Equal(t, int64(7), merged.Leechers)
+	assert.Equal(t, earlier, merged.UploadedAt.AsTime())
+}
@@ -0,0 +1,173 @@
+package anirent
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/Zaba505/anirent/proto"
+)
+
+// matchesReleaseGroups reports whether a result was released by one of the
+// release groups the request is searching for. Search engines match loosely
+// so results from other groups are common.
+func matchesReleaseGroups(result *pb.SearchResult, req *pb.SearchRequest) bool {
+	for _, group := range releaseGroups(req) {
+		if strings.EqualFold(result.ReleaseGroup, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesFilter reports whether a parsed result satisfies the filter. A nil
+// filter matches everything.
+func matchesFilter(result *pb.SearchResult, filter *pb.SearchFilter) bool {
+	if filter == nil {
+		return true
+	}
+
+	if filter.MinVersion > 0 && resultVersion(result) < filter.MinVersion {
+		return false
+	}
+	if !matchesMediaInfo(result.MediaInfo, filter) {
+		return false
+	}
+
+	switch x := result.Details.(type) {
+	case *pb.SearchResult_Episode:
+		if filter.ReleaseType == pb.ReleaseType_BATCHES_ONLY {
+			return false
+		}
+		return matchesSeason(x.Episode.Season, filter) && matchesEpisodeRange(x.Episode.Number, x.Episode.Number, filter)
+	case *pb.SearchResult_Season:
+		if filter.ReleaseType == pb.ReleaseType_EPISODES_ONLY {
+			return false
+		}
+		if !matchesSeason(x.Season.Number, filter) {
+			return false
+		}
+
+		// A batch matches if any of its episodes fall within the range.
+		first, last := episodeBounds(x.Season.Episodes)
+		return matchesEpisodeRange(first, last, filter)
+	case *pb.SearchResult_Special:
+		// Specials aren't regular episodes so the episode range doesn't
+		// apply to them.
+		return filter.ReleaseType != pb.ReleaseType_BATCHES_ONLY && matchesSeason(x.Special.Season, filter)
+	case *pb.SearchResult_Movie:
+		return filter.ReleaseType != pb.ReleaseType_BATCHES_ONLY
+	default:
+		return true
+	}
+}
+
+func matchesMediaInfo(info *pb.MediaInfo, filter *pb.SearchFilter) bool {
+	codec := info.GetVideoCodec()
+	for _, excluded := range filter.ExcludeVideoCodecs {
+		if codec != pb.VideoCodec_UNKNOWN_VIDEO_CODEC && codec == excluded {
+			return false
+		}
+	}
+
+	if len(filter.Sources) == 0 {
+		return true
+	}
+	for _, source := range filter.Sources {
+		if info.GetSource() == source {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesSeason(season int64, filter *pb.SearchFilter) bool {
+	return filter.Season == 0 || season == filter.Season
+}
+
+// matchesEpisodeRange reports whether the episodes [first, last] overlap
+// the episode range of the filter.
+func matchesEpisodeRange(first, last int64, filter *pb.SearchFilter) bool {
+	if filter.FirstEpisode > 0 && last < filter.FirstEpisode {
+		return false
+	}
+	if filter.LastEpisode > 0 && first > filter.LastEpisode {
+		return false
+	}
+	return true
+}
+
+func episodeBounds(episodes []*pb.Episode) (int64, int64) {
+	if len(episodes) == 0 {
+		return 0, 0
+	}
+
+	first, last := episodes[0].Number, episodes[0].Number
+	for _, ep := range episodes[1:] {
+		if ep.Number < first {
+			first = ep.Number
+		}
+		if ep.Number > last {
+			last = ep.Number
+		}
+	}
+	return first, last
+}
+
+// resultVersion returns the release version of a result. The original
+// release is the first version and each revision after that e.g. 08v2 is
+// the second version.
+func resultVersion(result *pb.SearchResult) int64 {
+	if result.Revision > 1 {
+		return result.Revision
+	}
+	return 1
+}
+
+// latestRevisions drops results which have been superseded by a later
+// revision of the same release e.g. 08 is dropped when 08v2 from the same
+// group, at the same resolution, was also found. The order of the remaining
+// results is preserved.
+func latestRevisions(results []*pb.SearchResult) []*pb.SearchResult {
+	latest := make(map[string]int64, len(results))
+	for _, result := range results {
+		key := releaseKey(result)
+		if v := resultVersion(result); v > latest[key] {
+			latest[key] = v
+		}
+	}
+
+	kept := results[:0]
+	for _, result := range results {
+		if resultVersion(result) == latest[releaseKey(result)] {
+			kept = append(kept, result)
+		}
+	}
+	return kept
+}
+
+// releaseKey identifies a release independently of its revision. Different
+// encodes of the same episode e.g. HEVC and AVC are different releases.
+func releaseKey(result *pb.SearchResult) string {
+	key := fmt.Sprintf(
+		"%s|%s|%s|%s|%s|%d",
+		strings.ToLower(result.ReleaseGroup),
+		strings.ToLower(result.Name),
+		result.Resolution,
+		result.Format,
+		result.MediaInfo.GetVideoCodec(),
+		result.MediaInfo.GetBitDepth(),
+	)
+	switch x := result.Details.(type) {
+	case *pb.SearchResult_Episode:
+		return fmt.Sprintf("%s|episode %d:%d", key, x.Episode.Season, x.Episode.Number)
+	case *pb.SearchResult_Season:
+		first, last := episodeBounds(x.Season.Episodes)
+		return fmt.Sprintf("%s|season %d:%d-%d", key, x.Season.Number, first, last)
+	case *pb.SearchResult_Special:
+		return fmt.Sprintf("%s|special %d:%s:%d:%d.%s", key, x.Special.Season, x.Special.Kind, x.Special.Number, x.Special.AfterEpisode, x.Special.Fraction)
+	case *pb.SearchResult_Movie:
+		return fmt.Sprintf("%s|movie %d", key, x.Movie.Number)
+	default:
+		return key
+	}
+}
@@ -0,0 +1,177 @@
+package anirent
+
+import (
+	"testing"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesFilter(t *testing.T) {
+	ep := func(season, number int64) *pb.SearchResult {
+		return &pb.SearchResult{Details: episode(season, number)}
+	}
+	batch := func(season, first, last int64) *pb.SearchResult {
+		episodes := make([]*pb.Episode, 0, last-first+1)
+		for n := first; n <= last; n++ {
+			episodes = append(episodes, &pb.Episode{Season: season, Number: n})
+		}
+		return &pb.SearchResult{
+			Details: &pb.SearchResult_Season{
+				Season: &pb.CompleteSeason{Number: season, Episodes: episodes},
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name    string
+		Result  *pb.SearchResult
+		Filter  *pb.SearchFilter
+		Matches bool
+	}{
+		{
+			Name:    "No Filter",
+			Result:  ep(1, 8),
+			Matches: true,
+		},
+		{
+			Name:    "Episode In Range",
+			Result:  ep(1, 13),
+			Filter:  &pb.SearchFilter{FirstEpisode: 13, LastEpisode: 24},
+			Matches: true,
+		},
+		{
+			Name:    "Episode Before Range",
+			Result:  ep(1, 12),
+			Filter:  &pb.SearchFilter{FirstEpisode: 13, LastEpisode: 24},
+			Matches: false,
+		},
+		{
+			Name:    "Episode After Open Ended Range",
+			Result:  ep(1, 100),
+			Filter:  &pb.SearchFilter{FirstEpisode: 13},
+			Matches: true,
+		},
+		{
+			Name:    "Batch Overlapping Range",
+			Result:  batch(1, 1, 13),
+			Filter:  &pb.SearchFilter{FirstEpisode: 13, LastEpisode: 24},
+			Matches: true,
+		},
+		{
+			Name:    "Batch Outside Range",
+			Result:  batch(1, 1, 12),
+			Filter:  &pb.SearchFilter{FirstEpisode: 13, LastEpisode: 24},
+			Matches: false,
+		},
+		{
+			Name:    "Wrong Season",
+			Result:  ep(1, 3),
+			Filter:  &pb.SearchFilter{Season: 2},
+			Matches: false,
+		},
+		{
+			Name:    "Episodes Only",
+			Result:  batch(1, 1, 12),
+			Filter:  &pb.SearchFilter{ReleaseType: pb.ReleaseType_EPISODES_ONLY},
+			Matches: false,
+		},
+		{
+			Name:    "Batches Only",
+			Result:  ep(1, 3),
+			Filter:  &pb.SearchFilter{ReleaseType: pb.ReleaseType_BATCHES_ONLY},
+			Matches: false,
+		},
+		{
+			Name:    "Below Minimum Version",
+			Result:  ep(1, 3),
+			Filter:  &pb.SearchFilter{MinVersion: 2},
+			Matches: false,
+		},
+		{
+			Name:    "Revised Release Meets Minimum Version",
+			Result:  &pb.SearchResult{Revision: 2, Details: episode(1, 3)},
+			Filter:  &pb.SearchFilter{MinVersion: 2},
+			Matches: true,
+		},
+		{
+			Name:    "Excluded Video Codec",
+			Result:  &pb.SearchResult{MediaInfo: &pb.MediaInfo{VideoCodec: pb.VideoCodec_HEVC}, Details: episode(1, 3)},
+			Filter:  &pb.SearchFilter{ExcludeVideoCodecs: []pb.VideoCodec{pb.VideoCodec_HEVC}},
+			Matches: false,
+		},
+		{
+			Name:    "Untagged Video Codec",
+			Result:  ep(1, 3),
+			Filter:  &pb.SearchFilter{ExcludeVideoCodecs: []pb.VideoCodec{pb.VideoCodec_HEVC}},
+			Matches: true,
+		},
+		{
+			Name:    "Allowed Source",
+			Result:  &pb.SearchResult{MediaInfo: &pb.MediaInfo{Source: pb.Source_BLURAY}, Details: episode(1, 3)},
+			Filter:  &pb.SearchFilter{Sources: []pb.Source{pb.Source_BLURAY, pb.Source_WEB}},
+			Matches: true,
+		},
+		{
+			Name:    "Untagged Source",
+			Result:  ep(1, 3),
+			Filter:  &pb.SearchFilter{Sources: []pb.Source{pb.Source_BLURAY}},
+			Matches: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			assert.Equal(subT, testCase.Matches, matchesFilter(testCase.Result, testCase.Filter))
+		})
+	}
+}
+
+func TestLatestRevisions(t *testing.T) {
+	result := func(group string, number, revision int64) *pb.SearchResult {
+		return &pb.SearchResult{
+			Name:         "Tonikaku Kawaii",
+			ReleaseGroup: group,
+			Revision:     revision,
+			Details:      episode(1, number),
+		}
+	}
+
+	original := result("SubsPlease", 8, 0)
+	revised := result("SubsPlease", 8, 2)
+	otherGroup := result("Erai-raws", 8, 0)
+	otherEpisode := result("SubsPlease", 9, 0)
+
+	results := latestRevisions([]*pb.SearchResult{original, otherGroup, revised, otherEpisode})
+	assert.Equal(t, []*pb.SearchResult{otherGroup, revised, otherEpisode}, results)
+}
+
+func TestLatestRevisionsKeepsDecimalSpecials(t *testing.T) {
+	special := func(fraction string, revision int64) *pb.SearchResult {
+		return &pb.SearchResult{
+			Name:         "Tonikaku Kawaii",
+			ReleaseGroup: "SubsPlease",
+			Revision:     revision,
+			Details: &pb.SearchResult_Special{
+				Special: &pb.Special{Season: 1, AfterEpisode: 12, Fraction: fraction},
+			},
+		}
+	}
+
+	// 12.5v2 supersedes 12.5 but not 12.75.
+	half := special("5", 0)
+	revised := special("5", 2)
+	threeQuarters := special("75", 0)
+
+	results := latestRevisions([]*pb.SearchResult{half, threeQuarters, revised})
+	assert.Equal(t, []*pb.SearchResult{threeQuarters, revised}, results)
+}
+
+func TestMatchesReleaseGroups(t *testing.T) {
+	result := &pb.SearchResult{ReleaseGroup: "Erai-raws"}
+
+	assert.False(t, matchesReleaseGroups(result, &pb.SearchRequest{}))
+	assert.True(t, matchesReleaseGroups(result, &pb.SearchRequest{ReleaseGroups: []string{"SubsPlease", "erai-raws"}}))
+	assert.True(t, matchesReleaseGroups(&pb.SearchResult{ReleaseGroup: "SubsPlease"}, &pb.SearchRequest{}))
+}
@@ -0,0 +1,73 @@
+package anirent
+
+import (
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const btihPrefix = "urn:btih:"
+
+func buildMagnet(infoHash, name string) string {
+	params := url.Values{}
+	params.Set("dn", name)
+	return fmt.Sprintf("magnet:?xt=%s%s&%s", btihPrefix, infoHash, params.Encode())
+}
+
+// parseInfoHash extracts the BitTorrent v1 infohash from a magnet link and
+// normalizes it to lowercase hex. Both the hex and base32 encodings are
+// accepted.
+func parseInfoHash(magnet string) (string, error) {
+	u, err := url.Parse(magnet)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "magnet" {
+		return "", fmt.Errorf("not a magnet link - %s", magnet)
+	}
+
+	for _, xt := range u.Query()["xt"] {
+		if !strings.HasPrefix(strings.ToLower(xt), btihPrefix) {
+			continue
+		}
+		hash := xt[len(btihPrefix):]
+
+		switch len(hash) {
+		case 40:
+			_, err := hex.DecodeString(hash)
+			if err != nil {
+				return "", fmt.Errorf("invalid hex infohash - %s", hash)
+			}
+			return strings.ToLower(hash), nil
+		case 32:
+			b, err := base32.StdEncoding.DecodeString(strings.ToUpper(hash))
+			if err != nil {
+				return "", fmt.Errorf("invalid base32 infohash - %s", hash)
+			}
+			return hex.EncodeToString(b), nil
+		default:
+			return "", fmt.Errorf("invalid infohash length - %s", hash)
+		}
+	}
+	return "", fmt.Errorf("no infohash in magnet link - %s", magnet)
+}
+
+// parseDisplayName extracts the URL decoded display name (dn) from a magnet
+// link.
+func parseDisplayName(magnet string) (string, error) {
+	u, err := url.Parse(magnet)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "magnet" {
+		return "", fmt.Errorf("not a magnet link - %s", magnet)
+	}
+
+	dn := strings.TrimSpace(u.Query().Get("dn"))
+	if dn == "" {
+		return "", fmt.Errorf("no display name in magnet link - %s", magnet)
+	}
+	return dn, nil
+}
@@ -0,0 +1,62 @@
+package anirent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInfoHash(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Magnet   string
+		InfoHash string
+		Err      bool
+	}{
+		{
+			Name:     "Hex",
+			Magnet:   "magnet:?xt=urn:btih:8E0A5A0E1C2E3B4D5F60718293A4B5C6D7E8F901&dn=%5BSubsPlease%5D+Tonikaku+Kawaii",
+			InfoHash: "8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901",
+		},
+		{
+			Name:     "Base32",
+			Magnet:   "magnet:?xt=urn:btih:RYFFUDQ4FY5U2X3AOGBJHJFVY3L6R6IB&tr=udp%3A%2F%2Ftracker.example.com%3A1337",
+			InfoHash: "8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901",
+		},
+		{
+			Name:   "Missing Infohash",
+			Magnet: "magnet:?dn=Tonikaku+Kawaii",
+			Err:    true,
+		},
+		{
+			Name:   "Not A Magnet",
+			Magnet: "https://nyaa.si/download/1392781.torrent",
+			Err:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			infoHash, err := parseInfoHash(testCase.Magnet)
+			if testCase.Err {
+				assert.NotNil(subT, err)
+				return
+			}
+			if !assert.Nil(subT, err) {
+				return
+			}
+			assert.Equal(subT, testCase.InfoHash, infoHash)
+		})
+	}
+}
+
+func TestParseDisplayName(t *testing.T) {
+	dn, err := parseDisplayName("magnet:?xt=urn:btih:8E0A5A0E1C2E3B4D5F60718293A4B5C6D7E8F901&dn=%5BSubsPlease%5D+Tonikaku+Kawaii+-+08+%281080p%29+%5B37FBE4D6%5D.mkv")
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv", dn)
+
+	_, err = parseDisplayName("magnet:?xt=urn:btih:8E0A5A0E1C2E3B4D5F60718293A4B5C6D7E8F901")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,205 @@
+package anirent
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Zaba505/anirent/parser"
+	pb "github.com/Zaba505/anirent/proto"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+type nyaa struct {
+	baseURL string
+	client  *http.Client
+}
+
+// Nyaa returns a SearchProvider which queries the RSS feed of a Nyaa style
+// torrent tracker hosted at baseURL e.g. https://nyaa.si
+func Nyaa(baseURL string) SearchProvider {
+	return nyaa{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  http.DefaultClient,
+	}
+}
+
+func (nyaa) Name() string {
+	return "nyaa"
+}
+
+func (n nyaa) Search(ctx context.Context, req *pb.SearchRequest, resultCh chan<- RawResult) error {
+	limit := newResultLimit(req)
+
+	g, ctx := errgroup.WithContext(ctx)
+	for _, group := range releaseGroups(req) {
+		for _, resolution := range req.Resolutions {
+			res := parser.SprintResolution(resolution)
+			q := fmt.Sprintf("[%s] %s (%s)", group, req.AnimeName, res)
+
+			g.Go(func() error {
+				return n.searchFeed(ctx, q, limit, resultCh)
+			})
+		}
+	}
+
+	return g.Wait()
+}
+
+func (n nyaa) searchFeed(ctx context.Context, q string, limit *resultLimit, resultCh chan<- RawResult) error {
+	items, err := n.fetch(ctx, q)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if !limit.take() {
+			return nil
+		}
+
+		result, err := item.toRawResult()
+		if err != nil {
+			zap.L().Warn("skipping malformed nyaa item", zap.String("title", item.Title), zap.Error(err))
+			continue
+		}
+
+		zap.L().Debug("found search result", zap.String("torrent_name", result.TorrentName), zap.String("magnet", result.Magnet))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case resultCh <- result:
+		}
+	}
+	return nil
+}
+
+func (n nyaa) fetch(ctx context.Context, q string) ([]nyaaItem, error) {
+	params := url.Values{}
+	params.Set("page", "rss")
+	params.Set("q", q)
+	params.Set("c", "1_2") // Anime - English-translated
+	params.Set("f", "0")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.baseURL+"/?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "anirent-scraper")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from rss feed - %s", resp.Status)
+	}
+
+	var feed nyaaFeed
+	err = xml.NewDecoder(resp.Body).Decode(&feed)
+	if err != nil {
+		return nil, err
+	}
+	return feed.Channel.Items, nil
+}
+
+type nyaaFeed struct {
+	Channel struct {
+		Items []nyaaItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+// nyaaItem fields without a namespace match the nyaa:* extension elements
+// regardless of the namespace URI a mirror declares.
+type nyaaItem struct {
+	Title    string `xml:"title"`
+	Link     string `xml:"link"`
+	PubDate  string `xml:"pubDate"`
+	Seeders  int64  `xml:"seeders"`
+	Leechers int64  `xml:"leechers"`
+	InfoHash string `xml:"infoHash"`
+	Size     string `xml:"size"`
+}
+
+func (item nyaaItem) toRawResult() (RawResult, error) {
+	result := RawResult{
+		TorrentName: strings.TrimSpace(item.Title),
+		InfoHash:    strings.ToLower(item.InfoHash),
+		Seeders:     item.Seeders,
+		Leechers:    item.Leechers,
+	}
+
+	switch {
+	case strings.HasPrefix(item.Link, "magnet:"):
+		result.Magnet = item.Link
+	case item.InfoHash != "":
+		result.Magnet = buildMagnet(result.InfoHash, result.TorrentName)
+	default:
+		return result, fmt.Errorf("no magnet link or infohash")
+	}
+
+	if item.Size != "" {
+		size, err := parseSize(item.Size)
+		if err != nil {
+			return result, err
+		}
+		result.Size = size
+	}
+
+	if item.PubDate != "" {
+		pubDate, err := parsePubDate(item.PubDate)
+		if err != nil {
+			return result, err
+		}
+		result.PublishedAt = pubDate
+	}
+
+	return result, nil
+}
+
+var sizeUnits = map[string]float64{
+	"B":   1,
+	"KB":  1e3,
+	"MB":  1e6,
+	"GB":  1e9,
+	"TB":  1e12,
+	"KIB": 1 << 10,
+	"MIB": 1 << 20,
+	"GIB": 1 << 30,
+	"TIB": 1 << 40,
+}
+
+// parseSize parses human readable sizes like "1.4 GiB" into bytes.
+func parseSize(s string) (int64, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("invalid size - %s", s)
+	}
+
+	n, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size - %s", s)
+	}
+
+	unit, ok := sizeUnits[strings.ToUpper(fields[1])]
+	if !ok {
+		return 0, fmt.Errorf("unknown size unit - %s", fields[1])
+	}
+	return int64(n * unit), nil
+}
+
+func parsePubDate(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC1123Z, s)
+	if err != nil {
+		t, err = time.Parse(time.RFC1123, s)
+	}
+	return t.UTC(), err
+}
@@ -0,0 +1,90 @@
+package anirent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNyaaSearch(t *testing.T) {
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("q")
+		http.ServeFile(w, r, "testdata/nyaa.xml")
+	}))
+	defer srv.Close()
+
+	resultCh := make(chan RawResult, 10)
+	err := Nyaa(srv.URL).Search(context.Background(), &pb.SearchRequest{
+		AnimeName:   "Tonikaku Kawaii",
+		Resolutions: []pb.Resolution{pb.Resolution_P_1080},
+	}, resultCh)
+	close(resultCh)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	assert.Equal(t, "[SubsPlease] Tonikaku Kawaii (1080p)", query)
+	assert.Equal(t, []RawResult{
+		{
+			TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv",
+			Magnet:      "magnet:?xt=urn:btih:8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901&dn=%5BSubsPlease%5D+Tonikaku+Kawaii+-+08+%281080p%29+%5B37FBE4D6%5D.mkv",
+			InfoHash:    "8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901",
+			Size:        1503238553,
+			Seeders:     512,
+			Leechers:    3,
+			PublishedAt: time.Date(2020, time.November, 21, 16, 31, 26, 0, time.UTC),
+		},
+		{
+			TorrentName: "[SubsPlease] Tonikaku Kawaii (01-12) (1080p) [Batch]",
+			Magnet:      "magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567&dn=%5BSubsPlease%5D+Tonikaku+Kawaii+%2801-12%29+%281080p%29+%5BBatch%5D",
+			InfoHash:    "0123456789abcdef0123456789abcdef01234567",
+			Size:        18038862643,
+			Seeders:     87,
+			Leechers:    1,
+			PublishedAt: time.Date(2020, time.December, 19, 18, 2, 11, 0, time.UTC),
+		},
+	}, collect(resultCh))
+}
+
+func TestNyaaSearchBadStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	resultCh := make(chan RawResult, 10)
+	err := Nyaa(srv.URL).Search(context.Background(), &pb.SearchRequest{
+		AnimeName:   "Tonikaku Kawaii",
+		Resolutions: []pb.Resolution{pb.Resolution_P_1080},
+	}, resultCh)
+	assert.NotNil(t, err)
+}
+
+func TestParseSize(t *testing.T) {
+	testCases := []struct {
+		Size  string
+		Bytes int64
+	}{
+		{Size: "512 B", Bytes: 512},
+		{Size: "350.5 MiB", Bytes: 367525888},
+		{Size: "1.5 GiB", Bytes: 1610612736},
+		{Size: "2 GB", Bytes: 2000000000},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Size, func(subT *testing.T) {
+			n, err := parseSize(testCase.Size)
+			if !assert.Nil(subT, err) {
+				return
+			}
+			assert.Equal(subT, testCase.Bytes, n)
+		})
+	}
+}
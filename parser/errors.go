@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// ParseError describes where, and why, a torrent name failed to parse.
+type ParseError struct {
+	// Input is the torrent name which failed to parse.
+	Input string
+
+	// Offset is the byte offset of Token in Input.
+	Offset int
+
+	// Token is the offending token. It's empty if the end of Input was
+	// reached.
+	Token string
+
+	// Expected describes what was expected at Offset e.g. resolution. Each
+	// grammar which failed at Offset may have expected something different.
+	Expected []string
+
+	// Reason explains why Token was rejected when it was of the expected
+	// kind but its value wasn't e.g. an unknown resolution. It's empty when
+	// Token simply wasn't expected.
+	Reason string
+
+	// progress counts the items a grammar parsed after the name before
+	// failing. Grammars which got further past the name split it where
+	// the rest of the input expects so their errors are more telling.
+	progress int
+}
+
+func (e *ParseError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("parser: %s at offset %d", e.Reason, e.Offset)
+	}
+	return fmt.Sprintf("parser: unexpected %s at offset %d, expected %s", e.token(), e.Offset, strings.Join(e.Expected, " or "))
+}
+
+func (e *ParseError) token() string {
+	if e.Token == "" {
+		return "EOF"
+	}
+	return fmt.Sprintf("%q", e.Token)
+}
+
+// Explain renders the input with a caret under the offending token
+// followed by the error e.g.
+//
+//	[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].rar
+//	                                                     ^
+//	parser: unknown video file format - rar at offset 53
+func (e *ParseError) Explain() string {
+	var b strings.Builder
+	b.WriteString(e.Input)
+	b.WriteByte('\n')
+
+	// The caret is aligned by display width, not bytes, so names with
+	// multibyte or full-width characters still line up.
+	col := runewidth.StringWidth(e.Input[:e.Offset])
+	b.WriteString(strings.Repeat(" ", col))
+	b.WriteString("^\n")
+
+	b.WriteString(e.Error())
+	return b.String()
+}
+
+// better returns whichever error got furthest past the name or, if both
+// got as far, whichever occurred furthest into the input. Trying every
+// split of the name makes the offset misleading on its own e.g. the name
+// "Tonikaku Kawaii - 08" followed by the batch range (999p) fails as far
+// in as the name "Tonikaku Kawaii" followed by an unknown resolution. The
+// expectations of errors at the same offset are combined.
+func better(a, b *ParseError) *ParseError {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case b.progress > a.progress:
+		return b
+	case b.progress < a.progress:
+		return a
+	case b.Offset > a.Offset:
+		return b
+	case b.Offset < a.Offset:
+		return a
+	}
+
+	merged := *a
+	merged.Expected = append([]string(nil), a.Expected...)
+	for _, exp := range b.Expected {
+		if !containsString(merged.Expected, exp) {
+			merged.Expected = append(merged.Expected, exp)
+		}
+	}
+	return &merged
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
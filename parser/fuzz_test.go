@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// addSyntheticSeeds seeds f with every torrent name in the synthetic
+// fixture.
+func addSyntheticSeeds(f *testing.F) {
+	for _, name := range readSyntheticNames(f) {
+		f.Add(name)
+	}
+}
+
+func FuzzScan(f *testing.F) {
+	addSyntheticSeeds(f)
+
+	f.Fuzz(func(t *testing.T, src string) {
+		var vals []string
+		pos := 0
+		for _, i := range scanAll(src) {
+			if i.pos < pos || i.pos+len(i.val) > len(src) {
+				t.Fatalf("item %v at offset %d is out of order", i, i.pos)
+			}
+			if src[i.pos:i.pos+len(i.val)] != i.val {
+				t.Fatalf("item %v doesn't match the source at offset %d", i, i.pos)
+			}
+			if i.tok == eof && i.pos != len(src) {
+				t.Fatalf("eof at offset %d before the end of the source", i.pos)
+			}
+			pos = i.pos + len(i.val)
+			vals = append(vals, i.val)
+		}
+
+		// Only whitespace is skipped between items.
+		expected := strings.Join(strings.FieldsFunc(src, unicode.IsSpace), "")
+		if actual := strings.Join(vals, ""); actual != expected {
+			t.Fatalf("items %q don't cover the source %q", actual, expected)
+		}
+	})
+}
+
+func FuzzParse(f *testing.F) {
+	addSyntheticSeeds(f)
+
+	f.Fuzz(func(t *testing.T, src string) {
+		// Runtime errors are re-panicked by the parser so reaching the
+		// end of Match is enough to know none occurred.
+		result, grammar, err := Match(src)
+		if err != nil {
+			parseErr, ok := err.(*ParseError)
+			if !ok {
+				t.Fatalf("expected a *ParseError but got %T", err)
+			}
+			if parseErr.Input != src || parseErr.Offset < 0 || parseErr.Offset > len(src) {
+				t.Fatalf("error offset %d is outside of the input %q", parseErr.Offset, src)
+			}
+			parseErr.Explain()
+			return
+		}
+
+		if result == nil || result.Details == nil {
+			t.Fatalf("%s accepted %q without details", grammar, src)
+		}
+		if !containsString(Grammars(), grammar) {
+			t.Fatalf("unknown grammar %s", grammar)
+		}
+	})
+}
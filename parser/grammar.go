@@ -0,0 +1,319 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/Zaba505/anirent/proto"
+)
+
+// grammar parses torrent names which follow a single release naming
+// convention.
+type grammar struct {
+	name  string
+	parse func(p *parser)
+}
+
+// grammars are tried in order by Match so stricter grammars must come
+// before more lenient ones.
+var grammars = []grammar{
+	{name: "subsplease", parse: parseSubsPlease},
+	{name: "erai-raws", parse: parseEraiRaws},
+	{name: "bracketed-resolution", parse: parseBracketedResolution},
+	{name: "season-episode", parse: parseSeasonEpisode},
+	{name: "plex", parse: parsePlex},
+}
+
+// Grammars returns the names of the supported naming conventions in the
+// order they're tried.
+func Grammars() []string {
+	names := make([]string, 0, len(grammars))
+	for _, g := range grammars {
+		names = append(names, g.name)
+	}
+	return names
+}
+
+func isHyphen(i item) bool {
+	return i.tok == hyphen
+}
+
+// parseSubsPlease parses names like:
+//
+//	[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv
+//	[SubsPlease] Tonikaku Kawaii (01-12) (1080p) [Batch]
+//	[SubsPlease] Tonikaku Kawaii (Movie) (1080p) [37FBE4D6].mkv
+func parseSubsPlease(p *parser) {
+	// Release group label e.g. SubsPlease
+	p.parseReleaseGroup()
+
+	// Anime name
+	p.parseName("'-' or '(' after anime name", func(i item) bool {
+		return i.tok == hyphen || i.tok == lparen
+	}, parseSubsPleaseDetails)
+}
+
+func parseSubsPleaseDetails(p *parser) {
+	// Is it an episode, movie or season?
+	switch i := p.next(); i.tok {
+	case hyphen:
+		p.parseEpisodeDetails()
+	case lparen:
+		if next := p.peek(); strings.EqualFold(next.val, "movie") {
+			p.next()
+			p.parseMovie()
+			p.expect(rparen, "ending movie label")
+			break
+		}
+
+		start, end := p.parseEpisodeRange("-")
+		p.expect(rparen, "ending season episode range")
+		p.setSeason(p.currentSeason(), start, end)
+	default:
+		p.unexpected(i, "'-' or '(' after anime name")
+	}
+
+	// Resolution label
+	p.parseResolutionLabel(lparen, rparen)
+
+	// Unique label e.g. a CRC32 or Batch, which may follow other tags
+	if i := p.peek(); i.tok != lbrack {
+		p.unexpected(i, "starting unique label")
+	}
+	p.parseLabels()
+
+	// Video format file extension, batches are directories
+	if _, ok := p.result.Details.(*pb.SearchResult_Season); !ok {
+		p.parseFileExt()
+	}
+}
+
+// parseEraiRaws parses names like:
+//
+//	[Erai-raws] Tonikaku Kawaii - 08 [1080p][Multiple Subtitle].mkv
+//	[Erai-raws] Tonikaku Kawaii - 01 ~ 12 [1080p][Multiple Subtitle]
+func parseEraiRaws(p *parser) {
+	p.parseReleaseGroup()
+	p.parseName("'-' after anime name", isHyphen, parseEraiRawsDetails)
+}
+
+func parseEraiRawsDetails(p *parser) {
+	p.expect(hyphen, "'-' after anime name")
+
+	// A batch is an episode range separated by '~'
+	if next := p.peekAt(1); next.tok == ident && next.val == "~" {
+		start, end := p.parseEpisodeRange("~")
+		p.setSeason(p.currentSeason(), start, end)
+	} else {
+		p.parseEpisodeDetails()
+	}
+
+	p.expect(lbrack, "starting resolution label")
+	p.parseResolutionIdent()
+	p.expect(rbrack, "ending resolution label")
+
+	p.parseLabels()
+	p.parseOptionalFileExt()
+}
+
+// parseBracketedResolution parses names where the resolution label is
+// bracketed and may be followed by other tags e.g.
+//
+//	[Judas] Tonikaku Kawaii - 08 [1080p HEVC x265 10bit][Multi-Subs].mkv
+func parseBracketedResolution(p *parser) {
+	p.parseReleaseGroup()
+	p.parseName("'-' after anime name", isHyphen, parseBracketedResolutionDetails)
+}
+
+func parseBracketedResolutionDetails(p *parser) {
+	p.expect(hyphen, "'-' after anime name")
+	p.parseEpisodeDetails()
+
+	p.parseResolutionLabel(lbrack, rbrack)
+	p.parseLabels()
+	p.parseOptionalFileExt()
+}
+
+// seasonEpisodeRe matches SxxEyy episode numbering. The numbers aren't
+// bounded since the printer writes long running shows e.g. s01e1071.
+var seasonEpisodeRe = regexp.MustCompile(`^[Ss](\d+)[Ee](\d+)$`)
+
+func isSeasonEpisode(i item) bool {
+	return seasonEpisodeRe.MatchString(i.val)
+}
+
+// parseSeasonEpisodeNumber parses SxxEyy episode numbering e.g. S01E08.
+// Season 00 holds specials e.g. S00E02 is the second special.
+func (p *parser) parseSeasonEpisodeNumber(context string) {
+	i := p.expect(ident, context)
+	m := seasonEpisodeRe.FindStringSubmatch(i.val)
+	if m == nil {
+		p.unexpected(i, context)
+	}
+	season, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		p.errorf(i, context, "invalid season number - %s", err.Error())
+	}
+	number, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		p.errorf(i, context, "invalid episode number - %s", err.Error())
+	}
+
+	if season == 0 {
+		p.result.Details = &pb.SearchResult_Special{
+			Special: &pb.Special{
+				Kind:   pb.SpecialKind_SPECIAL,
+				Number: number,
+			},
+		}
+		return
+	}
+
+	p.result.Details = &pb.SearchResult_Episode{
+		Episode: &pb.Episode{
+			Season: season,
+			Number: number,
+		},
+	}
+}
+
+// parseSeasonEpisode parses names using SxxEyy episode numbering e.g.
+//
+//	[Group] Tonikaku Kawaii S01E08 [1080p].mkv
+//	[Group] Tonikaku Kawaii - S01E08 (1080p) [37FBE4D6].mkv
+func parseSeasonEpisode(p *parser) {
+	p.parseReleaseGroup()
+	p.parseName("season and episode number e.g. S01E08", isSeasonEpisode, parseSeasonEpisodeDetails)
+}
+
+func parseSeasonEpisodeDetails(p *parser) {
+	p.parseSeasonEpisodeNumber("season and episode number e.g. S01E08")
+
+	switch i := p.peek(); i.tok {
+	case lparen:
+		p.parseResolutionLabel(lparen, rparen)
+	case lbrack:
+		p.parseResolutionLabel(lbrack, rbrack)
+	default:
+		p.unexpected(i, "starting resolution label")
+	}
+
+	p.parseLabels()
+	p.parseOptionalFileExt()
+}
+
+// parsePlex parses the names printed by printer.ForPlex, which have no
+// release group, so files already in a library can be read back e.g.
+//
+//	Tonikaku Kawaii - s01e08 (1080p).mkv
+//	Tonikaku Kawaii - s00e05 (1080p).mkv
+//	Tonikaku Kawaii - Season 01 (1080p)
+//	Tonikaku Kawaii 2 (1080p).mkv
+//
+// Season folders only name the season so their episodes are unknown. Plex
+// names movies by title alone so any other name is a movie, numbered if
+// its title ends in a number.
+func parsePlex(p *parser) {
+	p.literalName = true
+
+	switch items := p.items[p.i:]; {
+	case containsItem(items, isSeasonEpisode):
+		p.parseName("season and episode number e.g. s01e08", isSeasonEpisode, parsePlexEpisodeDetails)
+	case hasSeasonLabel(items):
+		p.parseName("'-' after anime name", isHyphen, parsePlexSeasonDetails)
+	default:
+		p.parseName("'(' after movie name", func(i item) bool {
+			return i.tok == lparen
+		}, parsePlexMovieDetails)
+	}
+}
+
+func containsItem(items []item, f func(item) bool) bool {
+	for _, i := range items {
+		if f(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSeasonLabel reports whether items contain a season label following
+// the name e.g. "- Season 01"
+func hasSeasonLabel(items []item) bool {
+	for j := 0; j+2 < len(items); j++ {
+		if items[j].tok == hyphen && strings.EqualFold(items[j+1].val, "season") && isInt(items[j+2].val) {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePlexEpisodeDetails(p *parser) {
+	p.parseSeasonEpisodeNumber("season and episode number e.g. s01e08")
+	if x, ok := p.result.Details.(*pb.SearchResult_Special); ok {
+		readPlexSpecial(x.Special)
+	}
+	p.parseResolutionLabel(lparen, rparen)
+	p.parseOptionalFileExt()
+}
+
+// readPlexSpecial reads back the kind and number of a special from the
+// range of season 00 episode numbers the printer gave it e.g. s00e1002 is
+// OVA 2 and s00e11250 is 12.5.
+func readPlexSpecial(special *pb.Special) {
+	n := special.Number
+	switch {
+	case n >= 10000:
+		n -= 10000
+		special.Number = 0
+		special.AfterEpisode = n / 100
+		if fraction := n % 100; fraction%10 == 0 {
+			special.Fraction = strconv.FormatInt(fraction/10, 10)
+		} else {
+			special.Fraction = fmt.Sprintf("%02d", fraction)
+		}
+	case n == 2000 || n == 2001:
+		special.Kind = pb.SpecialKind(n - 2000)
+		special.Number = 0
+	case n > 1000 && n < 2000:
+		special.Kind = pb.SpecialKind_OVA
+		special.Number = n - 1000
+	}
+}
+
+func parsePlexSeasonDetails(p *parser) {
+	p.expect(hyphen, "'-' after anime name")
+	if i := p.expect(ident, "season label e.g. Season 01"); !strings.EqualFold(i.val, "season") {
+		p.unexpected(i, "season label e.g. Season 01")
+	}
+	season := p.parseInt("season number")
+
+	p.result.Details = &pb.SearchResult_Season{
+		Season: &pb.CompleteSeason{
+			Number: int64(season),
+		},
+	}
+
+	p.parseResolutionLabel(lparen, rparen)
+}
+
+func parsePlexMovieDetails(p *parser) {
+	movie := new(pb.Movie)
+
+	// Only numbers the printer could have written are movie numbers so
+	// titles like "Jujutsu Kaisen 0" are kept whole.
+	if words := strings.Fields(p.result.Name); len(words) > 1 {
+		last := words[len(words)-1]
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err == nil && n > 0 && strconv.FormatInt(n, 10) == last {
+			movie.Number = n
+			p.result.Name = strings.Join(words[:len(words)-1], " ")
+		}
+	}
+	p.result.Details = &pb.SearchResult_Movie{Movie: movie}
+
+	p.parseResolutionLabel(lparen, rparen)
+	p.parseOptionalFileExt()
+}
@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"regexp"
+	"strconv"
+
+	pb "github.com/Zaba505/anirent/proto"
+)
+
+// mediaTag recognizes a technical tag e.g. HEVC or 10bit in the text of a
+// label and records it on the media info.
+type mediaTag struct {
+	re    *regexp.Regexp
+	apply func(info *pb.MediaInfo, m []string)
+}
+
+func videoCodecTag(pattern string, codec pb.VideoCodec) mediaTag {
+	return mediaTag{
+		re: regexp.MustCompile(`(?i)\b(?:` + pattern + `)\b`),
+		apply: func(info *pb.MediaInfo, _ []string) {
+			info.VideoCodec = codec
+		},
+	}
+}
+
+func audioCodecTag(pattern string, codec pb.AudioCodec) mediaTag {
+	return mediaTag{
+		re: regexp.MustCompile(`(?i)\b(?:` + pattern + `)\b`),
+		apply: func(info *pb.MediaInfo, _ []string) {
+			for _, c := range info.AudioCodecs {
+				if c == codec {
+					return
+				}
+			}
+			info.AudioCodecs = append(info.AudioCodecs, codec)
+		},
+	}
+}
+
+func sourceTag(pattern string, source pb.Source) mediaTag {
+	return mediaTag{
+		re: regexp.MustCompile(`(?i)\b(?:` + pattern + `)\b`),
+		apply: func(info *pb.MediaInfo, _ []string) {
+			info.Source = source
+		},
+	}
+}
+
+func languageTag(pattern string, code string) mediaTag {
+	return mediaTag{
+		re: regexp.MustCompile(`(?i)\b(?:` + pattern + `)\b`),
+		apply: func(info *pb.MediaInfo, _ []string) {
+			for _, lang := range info.Languages {
+				if lang == code {
+					return
+				}
+			}
+			info.Languages = append(info.Languages, code)
+		},
+	}
+}
+
+var mediaTags = []mediaTag{
+	videoCodecTag(`avc|x264|h\.?264`, pb.VideoCodec_AVC),
+	videoCodecTag(`hevc|x265|h\.?265`, pb.VideoCodec_HEVC),
+	videoCodecTag(`av1`, pb.VideoCodec_AV1),
+	{
+		re: regexp.MustCompile(`(?i)\b(8|10|12)[ -]?bits?\b`),
+		apply: func(info *pb.MediaInfo, m []string) {
+			info.BitDepth, _ = strconv.ParseInt(m[1], 10, 64)
+		},
+	},
+	audioCodecTag(`aac(?:2\.0|5\.1)?`, pb.AudioCodec_AAC),
+	audioCodecTag(`flac`, pb.AudioCodec_FLAC),
+	audioCodecTag(`opus`, pb.AudioCodec_OPUS),
+	audioCodecTag(`e-?ac-?3|ddp(?:2\.0|5\.1)?`, pb.AudioCodec_EAC3),
+	audioCodecTag(`ac3`, pb.AudioCodec_AC3),
+	audioCodecTag(`dts(?:-hd)?`, pb.AudioCodec_DTS),
+	sourceTag(`hdtv|tv`, pb.Source_TV),
+	sourceTag(`dvd(?:rip)?`, pb.Source_DVD),
+	sourceTag(`web(?:[ -]?(?:dl|rip))?`, pb.Source_WEB),
+	sourceTag(`bd(?:rip)?|blu-?ray`, pb.Source_BLURAY),
+	{
+		re: regexp.MustCompile(`(?i)\bdual[ -]?audio\b`),
+		apply: func(info *pb.MediaInfo, _ []string) {
+			info.DualAudio = true
+		},
+	},
+	{
+		re: regexp.MustCompile(`(?i)\bmulti(?:ple)?[ -]?sub(?:s|titles?)?\b`),
+		apply: func(info *pb.MediaInfo, _ []string) {
+			info.MultiSubs = true
+		},
+	},
+	languageTag(`eng?|english`, "en"),
+	languageTag(`jpn?|japanese`, "ja"),
+	languageTag(`spa|esp|spanish`, "es"),
+	languageTag(`fre|fra|french`, "fr"),
+	languageTag(`ger|deu|german`, "de"),
+	languageTag(`por|pt-br|portuguese`, "pt"),
+}
+
+// parseMediaTags records the technical tags found in the text of a label
+// e.g. "1080p HEVC x265 10bit" on the result. The result is only given
+// media info if a tag is found.
+func (p *parser) parseMediaTags(text string) {
+	for _, tag := range mediaTags {
+		m := tag.re.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+
+		if p.result.MediaInfo == nil {
+			p.result.MediaInfo = new(pb.MediaInfo)
+		}
+		tag.apply(p.result.MediaInfo, m)
+	}
+}
@@ -2,11 +2,15 @@ package parser
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
 
 	pb "github.com/Zaba505/anirent/proto"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // Resolution represents the desired video resolution e.g. 720p, 1080p, 4k...
@@ -68,39 +72,93 @@ func SprintResolution(res pb.Resolution) Resolution {
 }
 
 var formatStringToProto = map[string]pb.Format{
-	"mkv": pb.Format_MKV,
+	"mkv":  pb.Format_MKV,
+	"mp4":  pb.Format_MP4,
+	"avi":  pb.Format_AVI,
+	"webm": pb.Format_WEBM,
+	"ts":   pb.Format_TS,
+}
+
+// FileFormat returns the video format of a file from its extension e.g.
+// episode.mkv, or UNSPECIFIED_FORMAT if it isn't a known video format.
+func FileFormat(name string) pb.Format {
+	ext := strings.TrimPrefix(path.Ext(name), ".")
+	return formatStringToProto[strings.ToLower(ext)]
 }
 
+// Parse parses a torrent name into a search result using the first grammar
+// which accepts it.
 func Parse(src string) (*pb.SearchResult, error) {
+	result, _, err := Match(src)
+	return result, err
+}
+
+// Match parses a torrent name like Parse but also reports the name of the
+// grammar which accepted it. If no grammar accepts the name, a *ParseError
+// from the grammars which got furthest past the name is returned.
+func Match(src string) (*pb.SearchResult, string, error) {
+	items := scanAll(src)
+
+	var bestErr *ParseError
+	for _, g := range grammars {
+		p := parser{src: src, items: items}
+
+		result, err := p.parse(g.parse)
+		if err == nil {
+			return result, g.name, nil
+		}
+		bestErr = better(bestErr, err)
+	}
+	return nil, "", bestErr
+}
+
+// scanAll scans every item from src, including the trailing eof item, so
+// grammars can be tried against the same items.
+func scanAll(src string) []item {
 	s := scan(src)
-	p := parser{s: s}
-	p.pk.tok = -1
 
-	return p.parse()
+	var items []item
+	for {
+		i := s.nextItem()
+		items = append(items, i)
+		if i.tok == eof {
+			return items
+		}
+	}
 }
 
 type parser struct {
-	s *scanner
-
-	pk item
+	src   string
+	items []item
+	i     int // index of the next item
 
 	result *pb.SearchResult
-	err    error
+	season int64 // parsed from the name, zero if the name has no season
+
+	// literalName keeps season markers in the name e.g. for names which
+	// were printed from a result, whose season was already split off.
+	literalName bool
 }
 
 func (p *parser) next() item {
-	if p.pk.tok > -1 {
-		i := p.pk
-		p.pk.tok = -1
-		return i
+	i := p.items[p.i]
+	if i.tok != eof {
+		p.i += 1
 	}
-
-	return p.s.nextItem()
+	return i
 }
 
 func (p *parser) peek() item {
-	p.pk = p.s.nextItem()
-	return p.pk
+	return p.items[p.i]
+}
+
+// peekAt returns the item n items ahead of the next item without
+// consuming anything.
+func (p *parser) peekAt(n int) item {
+	if p.i+n >= len(p.items) {
+		return p.items[len(p.items)-1]
+	}
+	return p.items[p.i+n]
 }
 
 func (p *parser) expect(tok token, context string) item {
@@ -111,114 +169,315 @@ func (p *parser) expect(tok token, context string) item {
 	return i
 }
 
-func (p *parser) errorf(format string, args ...interface{}) {
-	format = fmt.Sprintf("parser: %s", format)
-	panic(fmt.Errorf(format, args...))
+// errorf rejects the value of i, which was parsed as context.
+func (p *parser) errorf(i item, context string, format string, args ...interface{}) {
+	panic(&ParseError{
+		Input:    p.src,
+		Offset:   i.pos,
+		Token:    i.val,
+		Expected: []string{context},
+		Reason:   fmt.Sprintf(format, args...),
+	})
 }
 
 func (p *parser) unexpected(i item, context string) {
-	p.errorf("unexpected %s in %s", i, context)
+	panic(&ParseError{
+		Input:    p.src,
+		Offset:   i.pos,
+		Token:    i.val,
+		Expected: []string{context},
+	})
 }
 
-func (p *parser) recover(err *error) {
+func (p *parser) recover(err **ParseError) {
 	e := recover()
 	if e != nil {
 		if _, ok := e.(runtime.Error); ok {
 			panic(e)
 		}
-		*err = e.(error)
+		*err = e.(*ParseError)
 	}
 }
 
-func (p *parser) parse() (result *pb.SearchResult, err error) {
+func (p *parser) parse(f func(*parser)) (result *pb.SearchResult, err *ParseError) {
 	defer p.recover(&err)
 
-	result = p.parseResult()
+	p.result = new(pb.SearchResult)
+	f(p)
+	p.expect(eof, "end of torrent name")
+
+	result = p.result
 	return
 }
 
-func (p *parser) parseResult() *pb.SearchResult {
-	p.result = new(pb.SearchResult)
+func (p *parser) parseReleaseGroup() {
+	p.expect(lbrack, "starting release group label")
 
-	// SubsPlease label
-	p.expect(lbrack, "starting SubsPlease label")
-	p.expect(ident, "SubsPlease")
-	p.expect(rbrack, "ending SubsPlease label")
+	start := p.next()
+	i := start
+	for i.tok != rbrack {
+		if i.tok == eof || i.tok == lbrack {
+			p.unexpected(i, "release group label")
+		}
+		i = p.next()
+	}
 
-	// Anime name
-	p.parseName()
+	// Groups can contain spaces, hyphens and dots e.g. Erai-raws so the
+	// label is taken straight from the source.
+	group := strings.TrimSpace(p.src[start.pos:i.pos])
+	if group == "" {
+		p.errorf(i, "release group label", "empty release group label")
+	}
+	p.result.ReleaseGroup = group
+}
 
-	// Is it an episode or season?
-	switch i := p.next(); i.tok {
-	case hyphen:
-		p.parseEpisode()
-	case lparen:
-		p.parseSeason()
-	default:
-		p.unexpected(i, "expected '-' or '(' after anime name")
+// parseName parses the content name followed by the rest of the torrent
+// name, using rest, up to the end of the torrent name.
+//
+// Names may contain the items which usually separate them from what follows
+// e.g. "Re:Zero - Starting Life in Another World - 05" or "Dr. Stone - 03"
+// so the name is ended at each item satisfying isEnd, from the last to the
+// first, until rest succeeds. Names never span a bracketed label. The end
+// describes what isEnd accepts for errors.
+func (p *parser) parseName(end string, isEnd func(item) bool, rest func(*parser)) {
+	start := p.i
+
+	var ends []int
+	for j := start + 1; j < len(p.items); j++ {
+		i := p.items[j]
+		if i.tok == eof || i.tok == lbrack || i.tok == rbrack {
+			break
+		}
+		if isEnd(i) {
+			ends = append(ends, j)
+		}
+	}
+	if len(ends) == 0 {
+		p.errorf(p.peek(), "name", "no %s", end)
 	}
 
-	// Resolution label
-	p.parseResolution()
+	saved := proto.Clone(p.result).(*pb.SearchResult)
 
-	// Unique label
-	p.expect(lbrack, "starting unique label")
-	p.expect(ident, "unique label")
-	p.expect(rbrack, "ending unique label")
+	var bestErr *ParseError
+	for k := len(ends) - 1; k >= 0; k-- {
+		p.i = start
+		p.result = proto.Clone(saved).(*pb.SearchResult)
 
-	// Video format file extension
-	switch p.result.Details.(type) {
-	case *pb.SearchResult_Episode:
-		p.parseFileExt()
+		err := p.try(func() {
+			p.setName(ends[k])
+			rest(p)
+			p.expect(eof, "end of torrent name")
+		})
+		if err == nil {
+			return
+		}
+		if p.i > ends[k] {
+			err.progress = p.i - ends[k]
+		}
+		bestErr = better(bestErr, err)
 	}
-
-	return p.result
+	panic(bestErr)
 }
 
-func (p *parser) parseName() {
-	var ss []string
+// setName sets the name to the source text of the items up to, but not
+// including, the item at end.
+func (p *parser) setName(end int) {
+	if p.peek().tok != ident {
+		p.unexpected(p.peek(), "name")
+	}
 
-	i := p.peek()
-	for {
-		if i.tok != ident {
-			break
-		}
+	// A separator left trailing the name e.g. "Tonikaku Kawaii - S01E08"
+	// isn't part of it.
+	text := strings.TrimSpace(p.src[p.peek().pos:p.items[end].pos])
+	text = strings.TrimSuffix(text, "-")
+	p.i = end
 
-		ss = append(ss, i.val)
-		i = p.peek()
+	words := strings.Fields(text)
+	if !p.literalName {
+		words, p.season = splitSeason(words)
 	}
+	p.result.Name = strings.Join(words, " ")
+}
 
-	p.result.Name = strings.Join(ss, " ")
+// try runs f and returns the error it panics with, if any.
+func (p *parser) try(f func()) (err *ParseError) {
+	defer p.recover(&err)
+	f()
+	return nil
 }
 
+// currentSeason returns the season parsed from the name or, if the name
+// has none, the first season.
+func (p *parser) currentSeason() int64 {
+	if p.season > 0 {
+		return p.season
+	}
+	return 1
+}
+
+// episodeNumberRe matches episode numbers with an optional revision
+// suffix e.g. 08 or 08v2
+var episodeNumberRe = regexp.MustCompile(`^(\d+)(?:[vV](\d+))?$`)
+
 func (p *parser) parseEpisode() {
-	n := p.parseInt("episode number")
+	i := p.expect(ident, "episode number")
+	m := episodeNumberRe.FindStringSubmatch(i.val)
+	if m == nil {
+		p.errorf(i, "episode number", "invalid episode number - %s", i.val)
+	}
+
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		p.errorf(i, "episode number", "invalid integer - %s", err.Error())
+	}
 
+	var revision int64
+	if m[2] != "" {
+		revision, err = strconv.ParseInt(m[2], 10, 64)
+		if err != nil {
+			p.errorf(i, "episode number", "invalid revision - %s", err.Error())
+		}
+	}
+
+	p.result.Revision = revision
 	p.result.Details = &pb.SearchResult_Episode{
 		Episode: &pb.Episode{
-			Season: 1,
-			Number: int64(n),
+			Season:   p.currentSeason(),
+			Number:   n,
+			Revision: revision,
+		},
+	}
+}
+
+// specialRe matches labels identifying a special e.g. SP1, Special, OVA or
+// OAD2
+var specialRe = regexp.MustCompile(`^(?i:(sp|special|ova|oad))(\d*)$`)
+
+func isInt(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
+
+// parseEpisodeDetails parses what follows the name of an episodic release.
+// This is usually an episode number but may instead identify a special
+// e.g. 12.5, SP1 or OVA, or a movie.
+func (p *parser) parseEpisodeDetails() {
+	i := p.peek()
+	switch {
+	case i.tok == ident && strings.EqualFold(i.val, "movie"):
+		p.next()
+		p.parseMovie()
+	case i.tok == ident && specialRe.MatchString(i.val):
+		p.parseSpecial()
+	case p.peekAt(1).tok == dot && isInt(p.peekAt(2).val):
+		p.parseDecimalSpecial()
+	default:
+		p.parseEpisode()
+	}
+}
+
+// parseSpecial parses labelled specials e.g. SP1, OVA or OVA 2
+func (p *parser) parseSpecial() {
+	m := specialRe.FindStringSubmatch(p.next().val)
+
+	kind := pb.SpecialKind_SPECIAL
+	switch strings.ToLower(m[1]) {
+	case "ova", "oad":
+		kind = pb.SpecialKind_OVA
+	}
+
+	var n int64
+	switch {
+	case m[2] != "":
+		n, _ = strconv.ParseInt(m[2], 10, 64)
+	case p.peek().tok == ident && isInt(p.peek().val):
+		n = int64(p.parseInt("special number"))
+	}
+
+	p.result.Details = &pb.SearchResult_Special{
+		Special: &pb.Special{
+			Season: p.currentSeason(),
+			Kind:   kind,
+			Number: n,
 		},
 	}
 }
 
-func (p *parser) parseSeason() {
+// parseDecimalSpecial parses specials numbered between regular episodes
+// e.g. 12.5
+func (p *parser) parseDecimalSpecial() {
+	after := p.parseInt("episode number")
+	p.expect(dot, "decimal episode number")
+	i := p.peek()
+	p.parseInt("decimal episode number")
+	if strings.Trim(i.val, "0123456789") != "" {
+		p.errorf(i, "decimal episode number", "invalid decimal episode number - %s", i.val)
+	}
+
+	// Trailing zeros are dropped so 12.5 and 12.50 are the same special.
+	fraction := strings.TrimRight(i.val, "0")
+	if fraction == "" {
+		fraction = "0"
+	}
+
+	p.result.Details = &pb.SearchResult_Special{
+		Special: &pb.Special{
+			Season:       p.currentSeason(),
+			Kind:         pb.SpecialKind_SPECIAL,
+			AfterEpisode: int64(after),
+			Fraction:     fraction,
+		},
+	}
+}
+
+// parseMovie parses the optional number following a movie label e.g.
+// Movie 2
+func (p *parser) parseMovie() {
+	var n int64
+	if i := p.peek(); i.tok == ident && isInt(i.val) {
+		n = int64(p.parseInt("movie number"))
+	}
+
+	p.result.Details = &pb.SearchResult_Movie{
+		Movie: &pb.Movie{
+			Number: n,
+		},
+	}
+}
+
+// maxEpisodeRange bounds the number of episodes in a batch so a mistyped
+// range e.g. 01-1200000 can't exhaust memory.
+const maxEpisodeRange = 10000
+
+// parseEpisodeRange parses an inclusive range of episodes separated by sep
+// e.g. 01-12 or 01 ~ 12
+func (p *parser) parseEpisodeRange(sep string) (int, int) {
+	first := p.peek()
 	start := p.parseInt("first episode of season")
-	p.expect(hyphen, "season episode range")
+	if i := p.next(); i.val != sep {
+		p.unexpected(i, "season episode range")
+	}
+	last := p.peek()
 	end := p.parseInt("last episode of season")
-	p.expect(rparen, "ending season episode range")
 
-	episodes := make([]*pb.Episode, 0, end-start)
+	if end < start || end-start >= maxEpisodeRange {
+		p.errorf(first, "season episode range", "invalid episode range - %s", p.src[first.pos:last.pos+len(last.val)])
+	}
+	return start, end
+}
+
+func (p *parser) setSeason(season int64, start, end int) {
+	episodes := make([]*pb.Episode, 0, end-start+1)
 	for j := start; j < end+1; j++ {
 		episodes = append(episodes, &pb.Episode{
-			Season: 1,
+			Season: season,
 			Number: int64(j),
 		})
 	}
 
 	p.result.Details = &pb.SearchResult_Season{
 		Season: &pb.CompleteSeason{
-			Number:   1,
+			Number:   season,
 			Episodes: episodes,
 		},
 	}
@@ -228,29 +487,102 @@ func (p *parser) parseInt(context string) int {
 	i := p.expect(ident, context)
 	n, err := strconv.Atoi(i.val)
 	if err != nil {
-		p.errorf("invalid integer - %s", err.Error())
+		p.errorf(i, context, "invalid integer - %s", err.Error())
 	}
 	return n
 }
 
-func (p *parser) parseResolution() {
-	p.expect(lparen, "starting resolution label")
+func (p *parser) parseResolutionIdent() {
 	i := p.expect(ident, "resolution")
-	res, ok := flagResToProtoRes[Resolution(i.val)]
+	res, ok := flagResToProtoRes[Resolution(strings.ToLower(i.val))]
 	if !ok {
-		p.errorf("unknown resolution - %s", i.val)
+		p.errorf(i, "resolution", "unknown resolution - %s", i.val)
 	}
 
 	p.result.Resolution = res
-	p.expect(rparen, "ending resolution label")
+}
+
+// parseResolutionLabel parses a label, delimited by open and close,
+// holding the resolution and possibly other tags e.g. (1080p) or
+// [BD 1080p HEVC FLAC]
+func (p *parser) parseResolutionLabel(open, close token) {
+	p.expect(open, "starting resolution label")
+
+	start := p.peek()
+	found := false
+	i := p.next()
+	for ; i.tok != close; i = p.next() {
+		switch i.tok {
+		case ident:
+		case hyphen, dot:
+			continue
+		default:
+			p.unexpected(i, "ending resolution label")
+		}
+
+		res, ok := flagResToProtoRes[Resolution(strings.ToLower(i.val))]
+		if ok && !found {
+			p.result.Resolution = res
+			found = true
+		}
+	}
+
+	text := p.src[start.pos:i.pos]
+	if !found {
+		p.errorf(start, "resolution", "unknown resolution - %s", strings.TrimSpace(text))
+	}
+	p.parseMediaTags(text)
+}
+
+// crc32Re matches the CRC32 checksum labels release groups append to
+// file names e.g. 37FBE4D6
+var crc32Re = regexp.MustCompile(`^[0-9A-Fa-f]{8}$`)
+
+// parseCRC32 records i as the CRC32 label if it is one.
+func (p *parser) parseCRC32(i item) {
+	if i.tok == ident && crc32Re.MatchString(i.val) {
+		p.result.Crc32 = strings.ToUpper(i.val)
+	}
+}
+
+// parseLabels parses any bracketed labels e.g. [Multiple Subtitle][ENG]
+func (p *parser) parseLabels() {
+	for p.peek().tok == lbrack {
+		p.parseLabel()
+	}
+}
+
+// parseLabel parses a bracketed label which is either the CRC32 label or
+// holds other tags e.g. [HEVC 10bit] that are recorded if recognized.
+func (p *parser) parseLabel() {
+	open := p.expect(lbrack, "starting label")
+	if p.peekAt(1).tok == rbrack {
+		p.parseCRC32(p.peek())
+	}
+
+	i := p.next()
+	for ; i.tok != rbrack; i = p.next() {
+		if i.tok == eof || i.tok == lbrack {
+			p.unexpected(i, "label")
+		}
+	}
+	p.parseMediaTags(p.src[open.pos+len(open.val) : i.pos])
 }
 
 func (p *parser) parseFileExt() {
 	p.expect(dot, "video format file extension")
 	i := p.expect(ident, "video format file extension")
-	format, ok := formatStringToProto[i.val]
+	format, ok := formatStringToProto[strings.ToLower(i.val)]
 	if !ok {
-		p.errorf("unknown video file format - %s", i.val)
+		p.errorf(i, "video format file extension", "unknown video file format - %s", i.val)
 	}
 	p.result.Format = format
 }
+
+// parseOptionalFileExt parses the file extension of single file releases
+// if present. Batches are directories so never have one.
+func (p *parser) parseOptionalFileExt() {
+	if _, ok := p.result.Details.(*pb.SearchResult_Season); !ok && p.peek().tok == dot {
+		p.parseFileExt()
+	}
+}
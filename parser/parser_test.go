@@ -18,9 +18,28 @@ func TestValidFormats(t *testing.T) {
 			Name:        "Valid Episode Format",
 			TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv",
 			ExpectedResult: &pb.SearchResult{
-				Name:       "Tonikaku Kawaii",
-				Resolution: pb.Resolution_P_1080,
-				Format:     pb.Format_MKV,
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details: &pb.SearchResult_Episode{
+					Episode: &pb.Episode{
+						Season: 1,
+						Number: 8,
+					},
+				},
+			},
+		},
+		{
+			Name:        "Release Group With Spaces And Hyphens",
+			TorrentName: "[Anime-Time Subs] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Anime-Time Subs",
+				Crc32:        "37FBE4D6",
 				Details: &pb.SearchResult_Episode{
 					Episode: &pb.Episode{
 						Season: 1,
@@ -33,9 +52,10 @@ func TestValidFormats(t *testing.T) {
 			Name:        "Valid Season Format",
 			TorrentName: "[SubsPlease] Tonikaku Kawaii (01-03) (1080p) [Batch]",
 			ExpectedResult: &pb.SearchResult{
-				Name:       "Tonikaku Kawaii",
-				Resolution: pb.Resolution_P_1080,
-				Format:     pb.Format_MKV,
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_UNSPECIFIED_FORMAT,
+				ReleaseGroup: "SubsPlease",
 				Details: &pb.SearchResult_Season{
 					Season: &pb.CompleteSeason{
 						Number: 1,
@@ -70,3 +90,632 @@ func TestValidFormats(t *testing.T) {
 		})
 	}
 }
+
+func TestMatch(t *testing.T) {
+	episode := func(season, number int64) *pb.SearchResult_Episode {
+		return &pb.SearchResult_Episode{
+			Episode: &pb.Episode{
+				Season: season,
+				Number: number,
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name           string
+		TorrentName    string
+		Grammar        string
+		ExpectedResult *pb.SearchResult
+	}{
+		{
+			Name:        "SubsPlease",
+			TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details:      episode(1, 8),
+			},
+		},
+		{
+			Name:        "Erai-raws Episode",
+			TorrentName: "[Erai-raws] Tonikaku Kawaii - 08 [1080p][Multiple Subtitle].mkv",
+			Grammar:     "erai-raws",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Erai-raws",
+				MediaInfo:    &pb.MediaInfo{MultiSubs: true},
+				Details:      episode(1, 8),
+			},
+		},
+		{
+			Name:        "Erai-raws Batch",
+			TorrentName: "[Erai-raws] Tonikaku Kawaii - 01 ~ 02 [720p][Multiple Subtitle]",
+			Grammar:     "erai-raws",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_720,
+				ReleaseGroup: "Erai-raws",
+				MediaInfo:    &pb.MediaInfo{MultiSubs: true},
+				Details: &pb.SearchResult_Season{
+					Season: &pb.CompleteSeason{
+						Number: 1,
+						Episodes: []*pb.Episode{
+							{Season: 1, Number: 1},
+							{Season: 1, Number: 2},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "Bracketed Resolution With Tags",
+			TorrentName: "[Judas] Tonikaku Kawaii - 05 [1080p HEVC x265 10bit][Multi-Subs].mkv",
+			Grammar:     "bracketed-resolution",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Judas",
+				MediaInfo:    &pb.MediaInfo{VideoCodec: pb.VideoCodec_HEVC, BitDepth: 10, MultiSubs: true},
+				Details:      episode(1, 5),
+			},
+		},
+		{
+			Name:        "Season Episode",
+			TorrentName: "[ASW] Tonikaku Kawaii S02E05 [1080p HEVC][2CE8E7DE].mkv",
+			Grammar:     "season-episode",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "ASW",
+				Crc32:        "2CE8E7DE",
+				MediaInfo:    &pb.MediaInfo{VideoCodec: pb.VideoCodec_HEVC},
+				Details:      episode(2, 5),
+			},
+		},
+		{
+			Name:        "Season Episode With Hyphen",
+			TorrentName: "[Group] Tonikaku Kawaii - S01E08 (720p) [37FBE4D6].mkv",
+			Grammar:     "season-episode",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_720,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Group",
+				Crc32:        "37FBE4D6",
+				Details:      episode(1, 8),
+			},
+		},
+		{
+			Name:        "Short Season Suffix",
+			TorrentName: "[SubsPlease] Tonikaku Kawaii S2 - 03 (1080p) [A1B2C3D4].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "A1B2C3D4",
+				Details:      episode(2, 3),
+			},
+		},
+		{
+			Name:        "Season Word Suffix",
+			TorrentName: "[Erai-raws] Tonikaku Kawaii Season 3 - 01 [720p][Multiple Subtitle].mkv",
+			Grammar:     "erai-raws",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_720,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Erai-raws",
+				MediaInfo:    &pb.MediaInfo{MultiSubs: true},
+				Details:      episode(3, 1),
+			},
+		},
+		{
+			Name:        "Ordinal Season Suffix",
+			TorrentName: "[Judas] Tonikaku Kawaii 2nd Season - 05 [1080p HEVC][Multi-Subs].mkv",
+			Grammar:     "bracketed-resolution",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Judas",
+				MediaInfo:    &pb.MediaInfo{VideoCodec: pb.VideoCodec_HEVC, MultiSubs: true},
+				Details:      episode(2, 5),
+			},
+		},
+		{
+			Name:        "Roman Numeral Season Batch",
+			TorrentName: "[SubsPlease] Overlord IV (01-02) (1080p) [Batch]",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Overlord",
+				Resolution:   pb.Resolution_P_1080,
+				ReleaseGroup: "SubsPlease",
+				Details: &pb.SearchResult_Season{
+					Season: &pb.CompleteSeason{
+						Number: 4,
+						Episodes: []*pb.Episode{
+							{Season: 4, Number: 1},
+							{Season: 4, Number: 2},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "Name Ending In Roman Numeral",
+			TorrentName: "[SubsPlease] Final Fantasy VII - 03 (1080p) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Final Fantasy VII",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details:      episode(1, 3),
+			},
+		},
+		{
+			Name:        "Revised Episode",
+			TorrentName: "[SubsPlease] Tonikaku Kawaii - 08v2 (1080p) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Revision:     2,
+				Details: &pb.SearchResult_Episode{
+					Episode: &pb.Episode{Season: 1, Number: 8, Revision: 2},
+				},
+			},
+		},
+		{
+			Name:        "Decimal Special",
+			TorrentName: "[SubsPlease] Tonikaku Kawaii - 12.5 (1080p) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details: &pb.SearchResult_Special{
+					Special: &pb.Special{Season: 1, Kind: pb.SpecialKind_SPECIAL, AfterEpisode: 12, Fraction: "5"},
+				},
+			},
+		},
+		{
+			Name:        "Numbered Special",
+			TorrentName: "[Erai-raws] Tonikaku Kawaii S2 - SP1 [1080p][Multiple Subtitle].mkv",
+			Grammar:     "erai-raws",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Erai-raws",
+				MediaInfo:    &pb.MediaInfo{MultiSubs: true},
+				Details: &pb.SearchResult_Special{
+					Special: &pb.Special{Season: 2, Kind: pb.SpecialKind_SPECIAL, Number: 1},
+				},
+			},
+		},
+		{
+			Name:        "OVA",
+			TorrentName: "[SubsPlease] Tonikaku Kawaii - OVA (1080p) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details: &pb.SearchResult_Special{
+					Special: &pb.Special{Season: 1, Kind: pb.SpecialKind_OVA},
+				},
+			},
+		},
+		{
+			Name:        "Hyphenated Movie",
+			TorrentName: "[Judas] Tonikaku Kawaii - Movie 2 [1080p HEVC][Multi-Subs].mkv",
+			Grammar:     "bracketed-resolution",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Judas",
+				MediaInfo:    &pb.MediaInfo{VideoCodec: pb.VideoCodec_HEVC, MultiSubs: true},
+				Details: &pb.SearchResult_Movie{
+					Movie: &pb.Movie{Number: 2},
+				},
+			},
+		},
+		{
+			Name:        "Parenthesized Movie",
+			TorrentName: "[SubsPlease] Tonikaku Kawaii (Movie) (1080p) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details: &pb.SearchResult_Movie{
+					Movie: &pb.Movie{},
+				},
+			},
+		},
+		{
+			Name:        "Name With Hyphen",
+			TorrentName: "[SubsPlease] Re:Zero - Starting Life in Another World - 05 (1080p) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Re:Zero - Starting Life in Another World",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details:      episode(1, 5),
+			},
+		},
+		{
+			Name:        "Name With Dot",
+			TorrentName: "[Erai-raws] Dr. Stone - 03 [1080p][Multiple Subtitle].mkv",
+			Grammar:     "erai-raws",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Dr. Stone",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Erai-raws",
+				MediaInfo:    &pb.MediaInfo{MultiSubs: true},
+				Details:      episode(1, 3),
+			},
+		},
+		{
+			Name:        "Name With Parentheses",
+			TorrentName: "[SubsPlease] Mob Psycho 100 (TV) - 02 (1080p) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Mob Psycho 100 (TV)",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details:      episode(1, 2),
+			},
+		},
+		{
+			Name:        "Name With Hyphen Batch",
+			TorrentName: "[SubsPlease] Kaguya-sama wa Kokurasetai - Ultra Romantic (01-02) (1080p) [Batch]",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Kaguya-sama wa Kokurasetai - Ultra Romantic",
+				Resolution:   pb.Resolution_P_1080,
+				ReleaseGroup: "SubsPlease",
+				Details: &pb.SearchResult_Season{
+					Season: &pb.CompleteSeason{
+						Number: 1,
+						Episodes: []*pb.Episode{
+							{Season: 1, Number: 1},
+							{Season: 1, Number: 2},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "MP4 Episode",
+			TorrentName: "[Erai-raws] Tonikaku Kawaii - 08 [720p][Multiple Subtitle].MP4",
+			Grammar:     "erai-raws",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_720,
+				Format:       pb.Format_MP4,
+				ReleaseGroup: "Erai-raws",
+				MediaInfo:    &pb.MediaInfo{MultiSubs: true},
+				Details:      episode(1, 8),
+			},
+		},
+		{
+			Name:        "Tagged Resolution Label",
+			TorrentName: "[Beatrice-Raws] Tonikaku Kawaii - 08 (BD 1080p HEVC FLAC) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Beatrice-Raws",
+				Crc32:        "37FBE4D6",
+				MediaInfo: &pb.MediaInfo{
+					VideoCodec:  pb.VideoCodec_HEVC,
+					AudioCodecs: []pb.AudioCodec{pb.AudioCodec_FLAC},
+					Source:      pb.Source_BLURAY,
+				},
+				Details: episode(1, 8),
+			},
+		},
+		{
+			Name:        "Tags Before Resolution",
+			TorrentName: "[Group] Tonikaku Kawaii - 08 [WEB-DL 1080p x264 AAC][Dual Audio][ENG].mkv",
+			Grammar:     "bracketed-resolution",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Group",
+				MediaInfo: &pb.MediaInfo{
+					VideoCodec:  pb.VideoCodec_AVC,
+					AudioCodecs: []pb.AudioCodec{pb.AudioCodec_AAC},
+					Source:      pb.Source_WEB,
+					DualAudio:   true,
+					Languages:   []string{"en"},
+				},
+				Details: episode(1, 8),
+			},
+		},
+		{
+			Name:        "Single Letter Is Not A Season",
+			TorrentName: "[SubsPlease] Mobile Suit Gundam X - 08 (1080p) [37FBE4D6].mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Mobile Suit Gundam X",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details:      episode(1, 8),
+			},
+		},
+		{
+			Name:        "Chinese Lenticular Brackets",
+			TorrentName: "【悠哈璃羽字幕社】 夏日重现 － 11 【1080p】【简日双语】.mp4",
+			Grammar:     "erai-raws",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "夏日重现",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MP4,
+				ReleaseGroup: "悠哈璃羽字幕社",
+				Details:      episode(1, 11),
+			},
+		},
+		{
+			Name:        "Japanese Corner Brackets And Fullwidth Parentheses",
+			TorrentName: "「SubsPlease」 かぐや様は告らせたい － 08 （1080p） 「37FBE4D6」．mkv",
+			Grammar:     "subsplease",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "かぐや様は告らせたい",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "SubsPlease",
+				Crc32:        "37FBE4D6",
+				Details:      episode(1, 8),
+			},
+		},
+		{
+			Name:        "Season Episode Special",
+			TorrentName: "[Group] Tonikaku Kawaii S00E02 [1080p].mkv",
+			Grammar:     "season-episode",
+			ExpectedResult: &pb.SearchResult{
+				Name:         "Tonikaku Kawaii",
+				Resolution:   pb.Resolution_P_1080,
+				Format:       pb.Format_MKV,
+				ReleaseGroup: "Group",
+				Details: &pb.SearchResult_Special{
+					Special: &pb.Special{Kind: pb.SpecialKind_SPECIAL, Number: 2},
+				},
+			},
+		},
+		{
+			Name:        "Plex Episode",
+			TorrentName: "Tonikaku Kawaii - s01e08 (1080p).mkv",
+			Grammar:     "plex",
+			ExpectedResult: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_1080,
+				Format:     pb.Format_MKV,
+				Details:    episode(1, 8),
+			},
+		},
+		{
+			Name:        "Plex Name Keeps Season Markers",
+			TorrentName: "Overlord II - s02e03 (720p).mkv",
+			Grammar:     "plex",
+			ExpectedResult: &pb.SearchResult{
+				Name:       "Overlord II",
+				Resolution: pb.Resolution_P_720,
+				Format:     pb.Format_MKV,
+				Details:    episode(2, 3),
+			},
+		},
+		{
+			Name:        "Plex Special",
+			TorrentName: "Tonikaku Kawaii - s00e05 (1080p).mkv",
+			Grammar:     "plex",
+			ExpectedResult: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_1080,
+				Format:     pb.Format_MKV,
+				Details: &pb.SearchResult_Special{
+					Special: &pb.Special{Kind: pb.SpecialKind_SPECIAL, Number: 5},
+				},
+			},
+		},
+		{
+			Name:        "Plex Season Folder",
+			TorrentName: "Tonikaku Kawaii - Season 02 (1080p)",
+			Grammar:     "plex",
+			ExpectedResult: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_1080,
+				Details: &pb.SearchResult_Season{
+					Season: &pb.CompleteSeason{Number: 2},
+				},
+			},
+		},
+		{
+			Name:        "Plex Long Running Episode",
+			TorrentName: "One Piece - s01e10000 (1080p).mkv",
+			Grammar:     "plex",
+			ExpectedResult: &pb.SearchResult{
+				Name:       "One Piece",
+				Resolution: pb.Resolution_P_1080,
+				Format:     pb.Format_MKV,
+				Details:    episode(1, 10000),
+			},
+		},
+		{
+			Name:        "Plex Movie",
+			TorrentName: "Kimetsu no Yaiba (Mugen Train) (1080p).mp4",
+			Grammar:     "plex",
+			ExpectedResult: &pb.SearchResult{
+				Name:       "Kimetsu no Yaiba (Mugen Train)",
+				Resolution: pb.Resolution_P_1080,
+				Format:     pb.Format_MP4,
+				Details:    &pb.SearchResult_Movie{Movie: &pb.Movie{}},
+			},
+		},
+		{
+			Name:        "Plex Movie Title Ending In Zero",
+			TorrentName: "Jujutsu Kaisen 0 (1080p).mkv",
+			Grammar:     "plex",
+			ExpectedResult: &pb.SearchResult{
+				Name:       "Jujutsu Kaisen 0",
+				Resolution: pb.Resolution_P_1080,
+				Format:     pb.Format_MKV,
+				Details:    &pb.SearchResult_Movie{Movie: &pb.Movie{}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			resp, grammar, err := Match(testCase.TorrentName)
+			if !assert.Nil(subT, err) {
+				return
+			}
+
+			assert.Equal(subT, testCase.Grammar, grammar)
+			assert.Equal(subT, testCase.ExpectedResult, resp)
+		})
+	}
+}
+
+func TestMatchReportsFurthestError(t *testing.T) {
+	_, _, err := Match("[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv trailing")
+	if !assert.NotNil(t, err) {
+		return
+	}
+	assert.Contains(t, err.Error(), "end of torrent name")
+}
+
+func TestParseError(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		TorrentName string
+		Offset      int
+		Token       string
+		Expected    []string
+		Reason      string
+	}{
+		{
+			Name:        "Unknown File Extension",
+			TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].rar",
+			Offset:      53,
+			Token:       "rar",
+			Expected:    []string{"video format file extension"},
+			Reason:      "unknown video file format - rar",
+		},
+		{
+			Name:        "Missing Resolution",
+			TorrentName: "[Group] Tonikaku Kawaii - 08",
+			Offset:      28,
+			Expected:    []string{"starting resolution label"},
+		},
+		{
+			Name:        "Unknown Resolution After Episode",
+			TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (999p) [37FBE4D6].mkv",
+			Offset:      35,
+			Token:       "999p",
+			Expected:    []string{"resolution"},
+			Reason:      "unknown resolution - 999p",
+		},
+		{
+			Name:        "Reversed Episode Range",
+			TorrentName: "[SubsPlease] Tonikaku Kawaii (12-01) (1080p) [Batch]",
+			Offset:      30,
+			Token:       "12",
+			Expected:    []string{"season episode range"},
+			Reason:      "invalid episode range - 12-01",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			_, err := Parse(testCase.TorrentName)
+
+			parseErr, ok := err.(*ParseError)
+			if !assert.True(subT, ok, "expected a *ParseError but got %T", err) {
+				return
+			}
+			assert.Equal(subT, testCase.TorrentName, parseErr.Input)
+			assert.Equal(subT, testCase.Offset, parseErr.Offset)
+			assert.Equal(subT, testCase.Token, parseErr.Token)
+			assert.Equal(subT, testCase.Expected, parseErr.Expected)
+			assert.Equal(subT, testCase.Reason, parseErr.Reason)
+		})
+	}
+}
+
+func TestParseInvalidEpisodeRange(t *testing.T) {
+	for _, name := range []string{
+		"[SubsPlease] Tonikaku Kawaii (12-01) (1080p) [Batch]",
+		"[SubsPlease] Tonikaku Kawaii (01-99999) (1080p) [Batch]",
+	} {
+		_, err := Parse(name)
+
+		_, ok := err.(*ParseError)
+		assert.True(t, ok, "expected a *ParseError for %s but got %T", name, err)
+	}
+}
+
+func TestParseErrorExplain(t *testing.T) {
+	_, err := Parse("[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].rar")
+	if !assert.NotNil(t, err) {
+		return
+	}
+
+	expected := "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].rar\n" +
+		"                                                     ^\n" +
+		"parser: unknown video file format - rar at offset 53"
+	assert.Equal(t, expected, err.(*ParseError).Explain())
+}
+
+func TestFileFormat(t *testing.T) {
+	assert.Equal(t, pb.Format_MKV, FileFormat("Tonikaku Kawaii/[SubsPlease] Tonikaku Kawaii - 01 (1080p) [37FBE4D6].mkv"))
+	assert.Equal(t, pb.Format_WEBM, FileFormat("NCOP.WEBM"))
+	assert.Equal(t, pb.Format_UNSPECIFIED_FORMAT, FileFormat("[SubsPlease] Tonikaku Kawaii - 01 (1080p) [37FBE4D6].ass"))
+	assert.Equal(t, pb.Format_UNSPECIFIED_FORMAT, FileFormat("Tonikaku Kawaii"))
+}
+
+func TestParseErrorExplainFullWidth(t *testing.T) {
+	_, err := Parse("[SubsPlease] 無職転生 - 08 (1080p) [37FBE4D6].rar")
+	if !assert.NotNil(t, err) {
+		return
+	}
+
+	// Each of the four CJK characters takes up two columns.
+	expected := "[SubsPlease] 無職転生 - 08 (1080p) [37FBE4D6].rar\n" +
+		"                                              ^\n" +
+		"parser: unknown video file format - rar at offset 50"
+	assert.Equal(t, expected, err.(*ParseError).Explain())
+}
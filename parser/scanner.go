@@ -2,7 +2,7 @@ package parser
 
 import (
 	"fmt"
-	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -36,14 +36,15 @@ func (s *scanner) backup() {
 	s.width = 0
 }
 
-func (s *scanner) acceptUntil(chars string) {
+// acceptIdent accepts runes up to the next delimiter or space.
+func (s *scanner) acceptIdent() {
 	for {
 		r := s.next()
 		if r == eofRune {
 			break
 		}
 
-		if strings.ContainsRune(chars, r) {
+		if _, ok := delimiters[r]; ok || unicode.IsSpace(r) {
 			s.backup()
 			break
 		}
@@ -53,6 +54,7 @@ func (s *scanner) acceptUntil(chars string) {
 type item struct {
 	tok token
 	val string
+	pos int // byte offset of val in the source
 }
 
 func (i item) String() string {
@@ -68,6 +70,7 @@ func (s *scanner) nextItem() item {
 	if s.cur == len(s.src) {
 		return item{
 			tok: eof,
+			pos: s.cur,
 		}
 	}
 
@@ -76,10 +79,11 @@ func (s *scanner) nextItem() item {
 		if r == eofRune {
 			return item{
 				tok: eof,
+				pos: s.cur,
 			}
 		}
 
-		if r == ' ' || r == '\t' {
+		if unicode.IsSpace(r) {
 			s.start = s.cur
 			r = s.next()
 			continue
@@ -87,28 +91,16 @@ func (s *scanner) nextItem() item {
 		break
 	}
 
-	var tok token
-	switch r {
-	case '(':
-		tok = lparen
-	case ')':
-		tok = rparen
-	case '[':
-		tok = lbrack
-	case ']':
-		tok = rbrack
-	case '-':
-		tok = hyphen
-	case '.':
-		tok = dot
-	default:
-		s.acceptUntil("()[]-. \t")
+	tok, ok := delimiters[r]
+	if !ok {
+		s.acceptIdent()
 		tok = ident
 	}
 
 	item := item{
 		tok: tok,
 		val: s.src[s.start:s.cur],
+		pos: s.start,
 	}
 	s.start = s.cur
 	return item
@@ -34,6 +34,42 @@ func TestScan(t *testing.T) {
 				{tok: eof},
 			},
 		},
+		{
+			Name:        "Lenticular Brackets And Fullwidth Hyphen",
+			TorrentName: "【悠哈璃羽字幕社】 夏日重现 － 11 【1080p】.mp4",
+			Items: []item{
+				{tok: lbrack, val: "【"},
+				{tok: ident, val: "悠哈璃羽字幕社"},
+				{tok: rbrack, val: "】"},
+				{tok: ident, val: "夏日重现"},
+				{tok: hyphen, val: "－"},
+				{tok: ident, val: "11"},
+				{tok: lbrack, val: "【"},
+				{tok: ident, val: "1080p"},
+				{tok: rbrack, val: "】"},
+				{tok: dot, val: "."},
+				{tok: ident, val: "mp4"},
+				{tok: eof},
+			},
+		},
+		{
+			Name:        "Corner Brackets And Fullwidth Parentheses",
+			TorrentName: "「SubsPlease」\u3000かぐや様は告らせたい – 08 （1080p）．mkv",
+			Items: []item{
+				{tok: lbrack, val: "「"},
+				{tok: ident, val: "SubsPlease"},
+				{tok: rbrack, val: "」"},
+				{tok: ident, val: "かぐや様は告らせたい"},
+				{tok: hyphen, val: "–"},
+				{tok: ident, val: "08"},
+				{tok: lparen, val: "（"},
+				{tok: ident, val: "1080p"},
+				{tok: rparen, val: "）"},
+				{tok: dot, val: "．"},
+				{tok: ident, val: "mkv"},
+				{tok: eof},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -51,7 +87,13 @@ func TestScan(t *testing.T) {
 				}
 			}
 
-			assert.Equal(subT, len(testCase.Items), len(items))
+			if !assert.Equal(subT, len(testCase.Items), len(items)) {
+				return
+			}
+			for j, expected := range testCase.Items {
+				assert.Equal(subT, expected.tok, items[j].tok, "item %d", j)
+				assert.Equal(subT, expected.val, items[j].val, "item %d", j)
+			}
 		})
 	}
 }
@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	shortSeasonRe   = regexp.MustCompile(`^[Ss](\d{1,2})$`)            // S2
+	ordinalSeasonRe = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th)$`) // 2nd Season
+)
+
+// romanSeasons maps roman numeral suffixes to season numbers. Larger
+// numerals are left out since they're more often part of the actual name
+// e.g. Final Fantasy VII, and V and X are single letters.
+var romanSeasons = map[string]int64{
+	"II":  2,
+	"III": 3,
+	"IV":  4,
+}
+
+// splitSeason strips a trailing season marker from the words of a name
+// and returns the remaining words along with the season number. If there
+// is no season marker, season is zero.
+//
+// The following markers are recognized: S2, Season 2, 2nd Season and
+// roman numerals e.g. II.
+func splitSeason(words []string) ([]string, int64) {
+	n := len(words)
+
+	// Two word markers
+	if n > 2 {
+		a, b := words[n-2], words[n-1]
+		if strings.EqualFold(a, "Season") {
+			season, err := strconv.ParseInt(b, 10, 64)
+			if err == nil {
+				return words[:n-2], season
+			}
+		}
+		if m := ordinalSeasonRe.FindStringSubmatch(strings.ToLower(a)); m != nil && strings.EqualFold(b, "Season") {
+			season, _ := strconv.ParseInt(m[1], 10, 64)
+			return words[:n-2], season
+		}
+	}
+
+	// One word markers
+	if n > 1 {
+		last := words[n-1]
+		if m := shortSeasonRe.FindStringSubmatch(last); m != nil {
+			season, _ := strconv.ParseInt(m[1], 10, 64)
+			return words[:n-1], season
+		}
+		if season, ok := romanSeasons[last]; ok {
+			return words[:n-1], season
+		}
+	}
+
+	return words, 0
+}
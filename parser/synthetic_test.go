@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var update = flag.Bool("update", false, "update the golden results of the synthetic names")
+
+var (
+	syntheticPath = filepath.Join("testdata", "synthetic.txt")
+	goldenPath    = filepath.Join("testdata", "synthetic.golden")
+)
+
+// readSyntheticNames reads the torrent names, one per line, in the
+// synthetic fixture. The names aren't scraped from a provider. They're
+// written in the naming conventions of real release groups, with made up
+// CRC32 labels, along with names none of the grammars accept.
+func readSyntheticNames(tb testing.TB) []string {
+	f, err := os.Open(syntheticPath)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer f.Close()
+
+	var names []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if name := sc.Text(); name != "" {
+			names = append(names, name)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return names
+}
+
+// formatGolden formats the result of matching a torrent name as a single
+// line of the form "<name>\t<grammar>\t<result as JSON>" or, if the name
+// failed to parse, "<name>\terror\t<error>".
+func formatGolden(name string) string {
+	result, grammar, err := Match(name)
+	if err != nil {
+		return fmt.Sprintf("%s\terror\t%s", name, err)
+	}
+
+	b, err := protojson.Marshal(result)
+	if err != nil {
+		panic(err)
+	}
+
+	// protojson randomizes its whitespace so it's compacted away.
+	var buf bytes.Buffer
+	err = json.Compact(&buf, b)
+	if err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("%s\t%s\t%s", name, grammar, buf.String())
+}
+
+func TestSyntheticNames(t *testing.T) {
+	names := readSyntheticNames(t)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		lines = append(lines, formatGolden(name))
+	}
+
+	if *update {
+		err := os.WriteFile(goldenPath, []byte(strings.Join(lines, "\n")+"\n"), 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+	if !assert.Equal(t, len(names), len(golden), "golden results are out of date, run go test -update") {
+		return
+	}
+
+	for i, name := range names {
+		t.Run(name, func(subT *testing.T) {
+			assert.Equal(subT, golden[i], lines[i])
+		})
+	}
+}
@@ -27,3 +27,40 @@ var tokens = map[token]string{
 func (t token) String() string {
 	return tokens[t]
 }
+
+// delimiters maps the runes which delimit items to their token. Full-width
+// and CJK forms, used by many Japanese and Chinese release groups, are
+// normalized to the same tokens as their ASCII counterparts e.g. 【 and ［
+// are both lbrack.
+var delimiters = map[rune]token{
+	'(': lparen,
+	'（': lparen, // fullwidth left parenthesis
+
+	')': rparen,
+	'）': rparen, // fullwidth right parenthesis
+
+	'[': lbrack,
+	'［': lbrack, // fullwidth left square bracket
+	'【': lbrack, // left black lenticular bracket
+	'〖': lbrack, // left white lenticular bracket
+	'「': lbrack, // left corner bracket
+	'『': lbrack, // left white corner bracket
+	'〔': lbrack, // left tortoise shell bracket
+
+	']': rbrack,
+	'］': rbrack, // fullwidth right square bracket
+	'】': rbrack, // right black lenticular bracket
+	'〗': rbrack, // right white lenticular bracket
+	'」': rbrack, // right corner bracket
+	'』': rbrack, // right white corner bracket
+	'〕': rbrack, // right tortoise shell bracket
+
+	'-': hyphen,
+	'－': hyphen, // fullwidth hyphen-minus
+	'‐': hyphen, // hyphen
+	'–': hyphen, // en dash
+	'—': hyphen, // em dash
+
+	'.': dot,
+	'．': dot, // fullwidth full stop
+}
@@ -12,12 +12,23 @@ import (
 var (
 	funcs = map[string]any{
 		"getEpisode":    getEpisode,
-		"toLower":       strings.ToLower,
+		"getSeason":     getSeason,
+		"getSpecial":    getSpecial,
+		"getMovie":      getMovie,
 		"fmtResolution": fmtResolution,
+		"fmtExt":        fmtExt,
 		"padInt":        padInt,
 	}
 
-	plexTmpl = "{{.Name}} - {{with $x := getEpisode .Details}}s{{padInt .Season}}e{{padInt .Number}}{{end}} ({{fmtResolution .Resolution}}).{{toLower .Format.String}}"
+	// Plex keeps specials in season 00 and names movies by title alone.
+	// Batches are season folders so have no file extension.
+	plexTmpl = "{{.Name}}" +
+		"{{with getEpisode .Details}} - s{{padInt .Season}}e{{padInt .Number}}{{end}}" +
+		"{{with getSpecial .Details}} - s00e{{padInt .}}{{end}}" +
+		"{{with getSeason .Details}} - Season {{padInt .Number}}{{end}}" +
+		"{{with getMovie .Details}}{{if .Number}} {{.Number}}{{end}}{{end}}" +
+		" ({{fmtResolution .Resolution}})" +
+		"{{if not (getSeason .Details)}}{{fmtExt .Format}}{{end}}"
 )
 
 type Printer interface {
@@ -48,6 +59,69 @@ func getEpisode(v any) any {
 	return nil
 }
 
+func getSeason(v any) any {
+	switch x := v.(type) {
+	case *pb.SearchResult_Season:
+		return x.Season
+	}
+	return nil
+}
+
+// Plex only numbers specials within season 00 so each kind of special is
+// given its own range of episode numbers, which the plex grammar reads
+// back, to keep them from colliding:
+//
+//	s00e01-999    numbered specials e.g. SP1
+//	s00e1001-1999 numbered OVAs e.g. OVA 2
+//	s00e2000      unnumbered specials
+//	s00e2001      unnumbered OVAs
+//	s00e10000+    specials between regular episodes e.g. 12.5 as 11250
+const (
+	maxSpecialNumber = 999
+	ovaSpecialBase   = 1000
+	unnumberedBase   = 2000
+	decimalBase      = 10000
+)
+
+// getSpecial returns the episode number of a special within season 00.
+func getSpecial(v any) (any, error) {
+	x, ok := v.(*pb.SearchResult_Special)
+	if !ok {
+		return nil, nil
+	}
+	special := x.Special
+
+	switch {
+	case special.AfterEpisode > 0:
+		// The fraction takes up the last two digits e.g. 12.5 is
+		// 11250 and 12.05 is 11205.
+		fraction, err := strconv.ParseInt(special.Fraction, 10, 64)
+		if err != nil || len(special.Fraction) > 2 {
+			return nil, fmt.Errorf("printer: can't number special %d.%s within season 00", special.AfterEpisode, special.Fraction)
+		}
+		if len(special.Fraction) == 1 {
+			fraction *= 10
+		}
+		return decimalBase + special.AfterEpisode*100 + fraction, nil
+	case special.Number > maxSpecialNumber:
+		return nil, fmt.Errorf("printer: can't number %s %d within season 00", special.Kind, special.Number)
+	case special.Number > 0 && special.Kind == pb.SpecialKind_OVA:
+		return ovaSpecialBase + special.Number, nil
+	case special.Number > 0:
+		return special.Number, nil
+	default:
+		return unnumberedBase + int64(special.Kind), nil
+	}
+}
+
+func getMovie(v any) any {
+	switch x := v.(type) {
+	case *pb.SearchResult_Movie:
+		return x.Movie
+	}
+	return nil
+}
+
 func fmtResolution(v any) any {
 	res, ok := v.(pb.Resolution)
 	if !ok {
@@ -72,6 +146,20 @@ func fmtResolution(v any) any {
 	}
 }
 
+// fmtExt formats the file extension for a video format. Unspecified
+// formats have no extension.
+func fmtExt(v any) any {
+	format, ok := v.(pb.Format)
+	if !ok {
+		panic("fmtExt can only be used with a proto.Format")
+	}
+
+	if format == pb.Format_UNSPECIFIED_FORMAT {
+		return ""
+	}
+	return "." + strings.ToLower(format.String())
+}
+
 func padInt(v any) any {
 	switch n := v.(type) {
 	case int64:
@@ -1,9 +1,16 @@
 package printer
 
 import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/Zaba505/anirent/parser"
 	pb "github.com/Zaba505/anirent/proto"
+
+	"google.golang.org/protobuf/proto"
 )
 
 func TestForPlex(t *testing.T) {
@@ -30,3 +37,316 @@ func TestForPlex(t *testing.T) {
 		return
 	}
 }
+
+func TestForPlexSpecialsAndMovies(t *testing.T) {
+	special := func(s *pb.Special) *pb.SearchResult {
+		return &pb.SearchResult{
+			Name:       "Tonikaku Kawaii",
+			Resolution: pb.Resolution_P_1080,
+			Format:     pb.Format_MKV,
+			Details:    &pb.SearchResult_Special{Special: s},
+		}
+	}
+	movie := func(m *pb.Movie) *pb.SearchResult {
+		return &pb.SearchResult{
+			Name:       "Tonikaku Kawaii",
+			Resolution: pb.Resolution_P_1080,
+			Format:     pb.Format_MKV,
+			Details:    &pb.SearchResult_Movie{Movie: m},
+		}
+	}
+
+	testCases := []struct {
+		Name     string
+		Result   *pb.SearchResult
+		Expected string
+	}{
+		{
+			Name:     "Numbered Special",
+			Result:   special(&pb.Special{Season: 1, Number: 2}),
+			Expected: "Tonikaku Kawaii - s00e02 (1080p).mkv",
+		},
+		{
+			Name:     "Numbered OVA",
+			Result:   special(&pb.Special{Season: 1, Kind: pb.SpecialKind_OVA, Number: 2}),
+			Expected: "Tonikaku Kawaii - s00e1002 (1080p).mkv",
+		},
+		{
+			Name:     "Unnumbered Special",
+			Result:   special(&pb.Special{Season: 1}),
+			Expected: "Tonikaku Kawaii - s00e2000 (1080p).mkv",
+		},
+		{
+			Name:     "Unnumbered OVA",
+			Result:   special(&pb.Special{Season: 1, Kind: pb.SpecialKind_OVA}),
+			Expected: "Tonikaku Kawaii - s00e2001 (1080p).mkv",
+		},
+		{
+			Name:     "Decimal Special",
+			Result:   special(&pb.Special{Season: 1, AfterEpisode: 12, Fraction: "5"}),
+			Expected: "Tonikaku Kawaii - s00e11250 (1080p).mkv",
+		},
+		{
+			Name:     "Two Digit Decimal Special",
+			Result:   special(&pb.Special{Season: 1, AfterEpisode: 12, Fraction: "05"}),
+			Expected: "Tonikaku Kawaii - s00e11205 (1080p).mkv",
+		},
+		{
+			Name:     "Movie",
+			Result:   movie(&pb.Movie{}),
+			Expected: "Tonikaku Kawaii (1080p).mkv",
+		},
+		{
+			Name:     "Numbered Movie",
+			Result:   movie(&pb.Movie{Number: 2}),
+			Expected: "Tonikaku Kawaii 2 (1080p).mkv",
+		},
+	}
+
+	p := ForPlex()
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			s, err := p.Print(testCase.Result)
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+			if s != testCase.Expected {
+				subT.Log(s)
+				subT.Fail()
+			}
+		})
+	}
+}
+
+func TestForPlexUnnumberableSpecial(t *testing.T) {
+	for _, special := range []*pb.Special{
+		{Season: 1, Number: 1000},
+		{Season: 1, AfterEpisode: 12, Fraction: "125"},
+	} {
+		_, err := ForPlex().Print(&pb.SearchResult{
+			Name:       "Tonikaku Kawaii",
+			Resolution: pb.Resolution_P_1080,
+			Format:     pb.Format_MKV,
+			Details:    &pb.SearchResult_Special{Special: special},
+		})
+		if err == nil {
+			t.Errorf("expected an error printing %v", special)
+		}
+	}
+}
+
+func TestForPlexSeasonFolder(t *testing.T) {
+	s, err := ForPlex().Print(&pb.SearchResult{
+		Name:       "Tonikaku Kawaii",
+		Resolution: pb.Resolution_P_1080,
+		Format:     pb.Format_MKV,
+		Details: &pb.SearchResult_Season{
+			Season: &pb.CompleteSeason{Number: 1},
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if s != "Tonikaku Kawaii - Season 01 (1080p)" {
+		t.Log(s)
+		t.Fail()
+	}
+}
+
+func TestForPlexUnspecifiedFormat(t *testing.T) {
+	s, err := ForPlex().Print(&pb.SearchResult{
+		Name:       "Tonikaku Kawaii",
+		Resolution: pb.Resolution_P_1080,
+		Details: &pb.SearchResult_Episode{
+			Episode: &pb.Episode{
+				Season: 1,
+				Number: 8,
+			},
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if s != "Tonikaku Kawaii - s01e08 (1080p)" {
+		t.Log(s)
+		t.Fail()
+	}
+}
+
+func TestForPlexRoundTrip(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Result *pb.SearchResult
+	}{
+		{
+			Name: "Episode",
+			Result: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_1080,
+				Format:     pb.Format_MKV,
+				Details: &pb.SearchResult_Episode{
+					Episode: &pb.Episode{Season: 2, Number: 8},
+				},
+			},
+		},
+		{
+			Name: "Name With Hyphens",
+			Result: &pb.SearchResult{
+				Name:       "Re:Zero - Starting Life in Another World",
+				Resolution: pb.Resolution_K_4,
+				Format:     pb.Format_MP4,
+				Details: &pb.SearchResult_Episode{
+					Episode: &pb.Episode{Season: 1, Number: 1071},
+				},
+			},
+		},
+		{
+			Name: "Special",
+			Result: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_720,
+				Format:     pb.Format_MKV,
+				Details: &pb.SearchResult_Special{
+					Special: &pb.Special{Kind: pb.SpecialKind_SPECIAL, Number: 5},
+				},
+			},
+		},
+		{
+			Name: "OVA",
+			Result: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_720,
+				Format:     pb.Format_MKV,
+				Details: &pb.SearchResult_Special{
+					Special: &pb.Special{Kind: pb.SpecialKind_OVA, Number: 3},
+				},
+			},
+		},
+		{
+			Name: "Unnumbered OVA",
+			Result: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_720,
+				Format:     pb.Format_MKV,
+				Details: &pb.SearchResult_Special{
+					Special: &pb.Special{Kind: pb.SpecialKind_OVA},
+				},
+			},
+		},
+		{
+			Name: "Decimal Special",
+			Result: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_720,
+				Format:     pb.Format_MKV,
+				Details: &pb.SearchResult_Special{
+					Special: &pb.Special{Kind: pb.SpecialKind_SPECIAL, AfterEpisode: 12, Fraction: "5"},
+				},
+			},
+		},
+		{
+			Name: "Season Folder",
+			Result: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_1080,
+				Details: &pb.SearchResult_Season{
+					Season: &pb.CompleteSeason{Number: 2},
+				},
+			},
+		},
+		{
+			Name: "Numbered Movie",
+			Result: &pb.SearchResult{
+				Name:       "Kimetsu no Yaiba",
+				Resolution: pb.Resolution_P_1080,
+				Format:     pb.Format_MKV,
+				Details: &pb.SearchResult_Movie{
+					Movie: &pb.Movie{Number: 2},
+				},
+			},
+		},
+		{
+			Name: "Unspecified Format",
+			Result: &pb.SearchResult{
+				Name:       "Tonikaku Kawaii",
+				Resolution: pb.Resolution_P_1080,
+				Details: &pb.SearchResult_Episode{
+					Episode: &pb.Episode{Season: 1, Number: 8},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			s, err := ForPlex().Print(testCase.Result)
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+
+			result, grammar, err := parser.Match(s)
+			if err != nil {
+				subT.Error(err)
+				return
+			}
+			if grammar != "plex" || !proto.Equal(testCase.Result, result) {
+				subT.Logf("%s parsed by %s as %v", s, grammar, result)
+				subT.Fail()
+			}
+		})
+	}
+}
+
+// FuzzForPlexRoundTrip checks that any parsed torrent name printed for Plex
+// is read back by the plex grammar as a result which prints the same.
+func FuzzForPlexRoundTrip(f *testing.F) {
+	names, err := os.Open(filepath.Join("..", "parser", "testdata", "synthetic.txt"))
+	if err != nil {
+		f.Fatal(err)
+	}
+	defer names.Close()
+
+	sc := bufio.NewScanner(names)
+	for sc.Scan() {
+		f.Add(sc.Text())
+	}
+
+	p := ForPlex()
+	f.Fuzz(func(t *testing.T, name string) {
+		result, err := parser.Parse(name)
+		if err != nil {
+			return
+		}
+
+		printed, err := p.Print(result)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		reparsed, grammar, err := parser.Match(printed)
+		if err != nil {
+			t.Fatalf("%q printed from %q doesn't parse: %s", printed, name, err)
+		}
+		if grammar != "plex" {
+			t.Fatalf("%q printed from %q parsed by %s", printed, name, grammar)
+		}
+
+		// The details are checked too since e.g. a season folder printed
+		// like a movie would still reprint the same.
+		if original, read := fmt.Sprintf("%T", result.Details), fmt.Sprintf("%T", reparsed.Details); original != read {
+			t.Fatalf("%q printed from %q as %s read back as %s", printed, name, original, read)
+		}
+
+		reprinted, err := p.Print(reparsed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reprinted != printed {
+			t.Fatalf("%q printed from %q reprinted as %q", printed, name, reprinted)
+		}
+	})
+}
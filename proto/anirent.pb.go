@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,19 +21,427 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ReleaseType int32
+
+const (
+	ReleaseType_ANY_RELEASE   ReleaseType = 0
+	ReleaseType_EPISODES_ONLY ReleaseType = 1
+	ReleaseType_BATCHES_ONLY  ReleaseType = 2
+)
+
+// Enum value maps for ReleaseType.
+var (
+	ReleaseType_name = map[int32]string{
+		0: "ANY_RELEASE",
+		1: "EPISODES_ONLY",
+		2: "BATCHES_ONLY",
+	}
+	ReleaseType_value = map[string]int32{
+		"ANY_RELEASE":   0,
+		"EPISODES_ONLY": 1,
+		"BATCHES_ONLY":  2,
+	}
+)
+
+func (x ReleaseType) Enum() *ReleaseType {
+	p := new(ReleaseType)
+	*p = x
+	return p
+}
+
+func (x ReleaseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReleaseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_anirent_proto_enumTypes[0].Descriptor()
+}
+
+func (ReleaseType) Type() protoreflect.EnumType {
+	return &file_anirent_proto_enumTypes[0]
+}
+
+func (x ReleaseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReleaseType.Descriptor instead.
+func (ReleaseType) EnumDescriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{0}
+}
+
+type SortKey int32
+
+const (
+	SortKey_UNSORTED SortKey = 0
+	// Season then episode number. Complete seasons are ordered by their
+	// first episode.
+	SortKey_EPISODE_NUMBER SortKey = 1
+	SortKey_SEEDERS        SortKey = 2
+	SortKey_SIZE           SortKey = 3
+	SortKey_UPLOAD_DATE    SortKey = 4
+	// The order of the requested resolutions i.e. the first requested
+	// resolution is the most preferred.
+	SortKey_RESOLUTION_PREFERENCE SortKey = 5
+	// The quality of the source, from untagged, TV, DVD and web up to
+	// Blu-ray.
+	SortKey_SOURCE SortKey = 6
+)
+
+// Enum value maps for SortKey.
+var (
+	SortKey_name = map[int32]string{
+		0: "UNSORTED",
+		1: "EPISODE_NUMBER",
+		2: "SEEDERS",
+		3: "SIZE",
+		4: "UPLOAD_DATE",
+		5: "RESOLUTION_PREFERENCE",
+		6: "SOURCE",
+	}
+	SortKey_value = map[string]int32{
+		"UNSORTED":              0,
+		"EPISODE_NUMBER":        1,
+		"SEEDERS":               2,
+		"SIZE":                  3,
+		"UPLOAD_DATE":           4,
+		"RESOLUTION_PREFERENCE": 5,
+		"SOURCE":                6,
+	}
+)
+
+func (x SortKey) Enum() *SortKey {
+	p := new(SortKey)
+	*p = x
+	return p
+}
+
+func (x SortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_anirent_proto_enumTypes[1].Descriptor()
+}
+
+func (SortKey) Type() protoreflect.EnumType {
+	return &file_anirent_proto_enumTypes[1]
+}
+
+func (x SortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortKey.Descriptor instead.
+func (SortKey) EnumDescriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{1}
+}
+
+type NameSource int32
+
+const (
+	// The torrent name reported by the search provider.
+	NameSource_TORRENT_NAME NameSource = 0
+	// The display name (dn) of the magnet link, used when the torrent name
+	// reported by the provider couldn't be parsed e.g. it was truncated.
+	NameSource_MAGNET_DISPLAY_NAME NameSource = 1
+)
+
+// Enum value maps for NameSource.
+var (
+	NameSource_name = map[int32]string{
+		0: "TORRENT_NAME",
+		1: "MAGNET_DISPLAY_NAME",
+	}
+	NameSource_value = map[string]int32{
+		"TORRENT_NAME":        0,
+		"MAGNET_DISPLAY_NAME": 1,
+	}
+)
+
+func (x NameSource) Enum() *NameSource {
+	p := new(NameSource)
+	*p = x
+	return p
+}
+
+func (x NameSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NameSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_anirent_proto_enumTypes[2].Descriptor()
+}
+
+func (NameSource) Type() protoreflect.EnumType {
+	return &file_anirent_proto_enumTypes[2]
+}
+
+func (x NameSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NameSource.Descriptor instead.
+func (NameSource) EnumDescriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{2}
+}
+
+type VideoCodec int32
+
+const (
+	VideoCodec_UNKNOWN_VIDEO_CODEC VideoCodec = 0
+	// H.264 e.g. AVC or x264
+	VideoCodec_AVC VideoCodec = 1
+	// H.265 e.g. HEVC or x265
+	VideoCodec_HEVC VideoCodec = 2
+	VideoCodec_AV1  VideoCodec = 3
+)
+
+// Enum value maps for VideoCodec.
+var (
+	VideoCodec_name = map[int32]string{
+		0: "UNKNOWN_VIDEO_CODEC",
+		1: "AVC",
+		2: "HEVC",
+		3: "AV1",
+	}
+	VideoCodec_value = map[string]int32{
+		"UNKNOWN_VIDEO_CODEC": 0,
+		"AVC":                 1,
+		"HEVC":                2,
+		"AV1":                 3,
+	}
+)
+
+func (x VideoCodec) Enum() *VideoCodec {
+	p := new(VideoCodec)
+	*p = x
+	return p
+}
+
+func (x VideoCodec) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VideoCodec) Descriptor() protoreflect.EnumDescriptor {
+	return file_anirent_proto_enumTypes[3].Descriptor()
+}
+
+func (VideoCodec) Type() protoreflect.EnumType {
+	return &file_anirent_proto_enumTypes[3]
+}
+
+func (x VideoCodec) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VideoCodec.Descriptor instead.
+func (VideoCodec) EnumDescriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{3}
+}
+
+type AudioCodec int32
+
+const (
+	AudioCodec_UNKNOWN_AUDIO_CODEC AudioCodec = 0
+	AudioCodec_AAC                 AudioCodec = 1
+	AudioCodec_FLAC                AudioCodec = 2
+	AudioCodec_OPUS                AudioCodec = 3
+	AudioCodec_AC3                 AudioCodec = 4
+	AudioCodec_EAC3                AudioCodec = 5
+	AudioCodec_DTS                 AudioCodec = 6
+)
+
+// Enum value maps for AudioCodec.
+var (
+	AudioCodec_name = map[int32]string{
+		0: "UNKNOWN_AUDIO_CODEC",
+		1: "AAC",
+		2: "FLAC",
+		3: "OPUS",
+		4: "AC3",
+		5: "EAC3",
+		6: "DTS",
+	}
+	AudioCodec_value = map[string]int32{
+		"UNKNOWN_AUDIO_CODEC": 0,
+		"AAC":                 1,
+		"FLAC":                2,
+		"OPUS":                3,
+		"AC3":                 4,
+		"EAC3":                5,
+		"DTS":                 6,
+	}
+)
+
+func (x AudioCodec) Enum() *AudioCodec {
+	p := new(AudioCodec)
+	*p = x
+	return p
+}
+
+func (x AudioCodec) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AudioCodec) Descriptor() protoreflect.EnumDescriptor {
+	return file_anirent_proto_enumTypes[4].Descriptor()
+}
+
+func (AudioCodec) Type() protoreflect.EnumType {
+	return &file_anirent_proto_enumTypes[4]
+}
+
+func (x AudioCodec) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AudioCodec.Descriptor instead.
+func (AudioCodec) EnumDescriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{4}
+}
+
+type Source int32
+
+const (
+	Source_UNKNOWN_SOURCE Source = 0
+	// Recorded from a TV broadcast e.g. HDTV
+	Source_TV  Source = 1
+	Source_DVD Source = 2
+	// Streaming services e.g. WEB-DL or WEBRip
+	Source_WEB Source = 3
+	// Blu-ray discs e.g. BD or BDRip
+	Source_BLURAY Source = 4
+)
+
+// Enum value maps for Source.
+var (
+	Source_name = map[int32]string{
+		0: "UNKNOWN_SOURCE",
+		1: "TV",
+		2: "DVD",
+		3: "WEB",
+		4: "BLURAY",
+	}
+	Source_value = map[string]int32{
+		"UNKNOWN_SOURCE": 0,
+		"TV":             1,
+		"DVD":            2,
+		"WEB":            3,
+		"BLURAY":         4,
+	}
+)
+
+func (x Source) Enum() *Source {
+	p := new(Source)
+	*p = x
+	return p
+}
+
+func (x Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_anirent_proto_enumTypes[5].Descriptor()
+}
+
+func (Source) Type() protoreflect.EnumType {
+	return &file_anirent_proto_enumTypes[5]
+}
+
+func (x Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Source.Descriptor instead.
+func (Source) EnumDescriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{5}
+}
+
+type Verification int32
+
+const (
+	// The download couldn't be verified e.g. the search result had no
+	// CRC32 label or the torrent contained more than one file.
+	Verification_UNVERIFIED Verification = 0
+	// The CRC32 checksum of the downloaded file matched the label.
+	Verification_VERIFIED Verification = 1
+	// The CRC32 checksum of the downloaded file didn't match the label so
+	// the file is either corrupt or not the release which was searched for.
+	Verification_MISMATCH Verification = 2
+)
+
+// Enum value maps for Verification.
+var (
+	Verification_name = map[int32]string{
+		0: "UNVERIFIED",
+		1: "VERIFIED",
+		2: "MISMATCH",
+	}
+	Verification_value = map[string]int32{
+		"UNVERIFIED": 0,
+		"VERIFIED":   1,
+		"MISMATCH":   2,
+	}
+)
+
+func (x Verification) Enum() *Verification {
+	p := new(Verification)
+	*p = x
+	return p
+}
+
+func (x Verification) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Verification) Descriptor() protoreflect.EnumDescriptor {
+	return file_anirent_proto_enumTypes[6].Descriptor()
+}
+
+func (Verification) Type() protoreflect.EnumType {
+	return &file_anirent_proto_enumTypes[6]
+}
+
+func (x Verification) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Verification.Descriptor instead.
+func (Verification) EnumDescriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{6}
+}
+
 type Format int32
 
 const (
-	Format_MKV Format = 0
+	// The format is unknown e.g. a batch whose files haven't been seen yet.
+	Format_UNSPECIFIED_FORMAT Format = 0
+	Format_MKV                Format = 1
+	Format_MP4                Format = 2
+	Format_AVI                Format = 3
+	Format_WEBM               Format = 4
+	Format_TS                 Format = 5
 )
 
 // Enum value maps for Format.
 var (
 	Format_name = map[int32]string{
-		0: "MKV",
+		0: "UNSPECIFIED_FORMAT",
+		1: "MKV",
+		2: "MP4",
+		3: "AVI",
+		4: "WEBM",
+		5: "TS",
 	}
 	Format_value = map[string]int32{
-		"MKV": 0,
+		"UNSPECIFIED_FORMAT": 0,
+		"MKV":                1,
+		"MP4":                2,
+		"AVI":                3,
+		"WEBM":               4,
+		"TS":                 5,
 	}
 )
 
@@ -47,11 +456,11 @@ func (x Format) String() string {
 }
 
 func (Format) Descriptor() protoreflect.EnumDescriptor {
-	return file_anirent_proto_enumTypes[0].Descriptor()
+	return file_anirent_proto_enumTypes[7].Descriptor()
 }
 
 func (Format) Type() protoreflect.EnumType {
-	return &file_anirent_proto_enumTypes[0]
+	return &file_anirent_proto_enumTypes[7]
 }
 
 func (x Format) Number() protoreflect.EnumNumber {
@@ -60,7 +469,7 @@ func (x Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Format.Descriptor instead.
 func (Format) EnumDescriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{0}
+	return file_anirent_proto_rawDescGZIP(), []int{7}
 }
 
 // Resolution represents the desired video resolution e.g. 720p, 1080p, 4k...
@@ -106,11 +515,11 @@ func (x Resolution) String() string {
 }
 
 func (Resolution) Descriptor() protoreflect.EnumDescriptor {
-	return file_anirent_proto_enumTypes[1].Descriptor()
+	return file_anirent_proto_enumTypes[8].Descriptor()
 }
 
 func (Resolution) Type() protoreflect.EnumType {
-	return &file_anirent_proto_enumTypes[1]
+	return &file_anirent_proto_enumTypes[8]
 }
 
 func (x Resolution) Number() protoreflect.EnumNumber {
@@ -119,7 +528,56 @@ func (x Resolution) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Resolution.Descriptor instead.
 func (Resolution) EnumDescriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{1}
+	return file_anirent_proto_rawDescGZIP(), []int{8}
+}
+
+type SpecialKind int32
+
+const (
+	// A special episode e.g. SP1 or a recap numbered between regular
+	// episodes like 12.5
+	SpecialKind_SPECIAL SpecialKind = 0
+	// An original video animation e.g. OVA or OAD
+	SpecialKind_OVA SpecialKind = 1
+)
+
+// Enum value maps for SpecialKind.
+var (
+	SpecialKind_name = map[int32]string{
+		0: "SPECIAL",
+		1: "OVA",
+	}
+	SpecialKind_value = map[string]int32{
+		"SPECIAL": 0,
+		"OVA":     1,
+	}
+)
+
+func (x SpecialKind) Enum() *SpecialKind {
+	p := new(SpecialKind)
+	*p = x
+	return p
+}
+
+func (x SpecialKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpecialKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_anirent_proto_enumTypes[9].Descriptor()
+}
+
+func (SpecialKind) Type() protoreflect.EnumType {
+	return &file_anirent_proto_enumTypes[9]
+}
+
+func (x SpecialKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpecialKind.Descriptor instead.
+func (SpecialKind) EnumDescriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{9}
 }
 
 type SearchRequest struct {
@@ -131,6 +589,21 @@ type SearchRequest struct {
 	AnimeName string `protobuf:"bytes,1,opt,name=anime_name,json=animeName,proto3" json:"anime_name,omitempty"`
 	// Desired resolutions
 	Resolutions []Resolution `protobuf:"varint,2,rep,packed,name=resolutions,proto3,enum=proto.Resolution" json:"resolutions,omitempty"`
+	// The maximum number of result pages each provider should fetch per
+	// resolution. Zero means the provider default.
+	MaxPages int64 `protobuf:"varint,3,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
+	// The maximum number of results each provider should return. Zero means
+	// no limit.
+	MaxResults int64 `protobuf:"varint,4,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	// How results should be ordered. Options are applied in order with later
+	// options breaking ties between earlier ones. If empty, results are sent
+	// in the order they were found.
+	Sort []*SortOption `protobuf:"bytes,5,rep,name=sort,proto3" json:"sort,omitempty"`
+	// Restricts which results are returned.
+	Filter *SearchFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The release groups to search for e.g. SubsPlease, Erai-raws. Results
+	// from other groups are excluded. If empty, SubsPlease is searched for.
+	ReleaseGroups []string `protobuf:"bytes,7,rep,name=release_groups,json=releaseGroups,proto3" json:"release_groups,omitempty"`
 }
 
 func (x *SearchRequest) Reset() {
@@ -179,6 +652,203 @@ func (x *SearchRequest) GetResolutions() []Resolution {
 	return nil
 }
 
+func (x *SearchRequest) GetMaxPages() int64 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetMaxResults() int64 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetSort() []*SortOption {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetFilter() *SearchFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetReleaseGroups() []string {
+	if x != nil {
+		return x.ReleaseGroups
+	}
+	return nil
+}
+
+type SearchFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only include episodes numbered at least this. Zero means no lower bound.
+	FirstEpisode int64 `protobuf:"varint,1,opt,name=first_episode,json=firstEpisode,proto3" json:"first_episode,omitempty"`
+	// Only include episodes numbered at most this. Zero means no upper bound.
+	LastEpisode int64 `protobuf:"varint,2,opt,name=last_episode,json=lastEpisode,proto3" json:"last_episode,omitempty"`
+	// Only include results belonging to this season. Zero means any season.
+	Season int64 `protobuf:"varint,3,opt,name=season,proto3" json:"season,omitempty"`
+	// Restrict results to single episodes or complete season batches.
+	ReleaseType ReleaseType `protobuf:"varint,4,opt,name=release_type,json=releaseType,proto3,enum=proto.ReleaseType" json:"release_type,omitempty"`
+	// Only include releases with at least this version e.g. 2 excludes
+	// everything except revised releases like "08v2". Zero means any version.
+	MinVersion int64 `protobuf:"varint,5,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
+	// Exclude results encoded with any of these video codecs e.g. HEVC for
+	// devices which can't play it. Results without a codec tag are included.
+	ExcludeVideoCodecs []VideoCodec `protobuf:"varint,6,rep,packed,name=exclude_video_codecs,json=excludeVideoCodecs,proto3,enum=proto.VideoCodec" json:"exclude_video_codecs,omitempty"`
+	// Only include results from these sources. Results which don't tag their
+	// source are excluded. Empty means any source.
+	Sources []Source `protobuf:"varint,7,rep,packed,name=sources,proto3,enum=proto.Source" json:"sources,omitempty"`
+}
+
+func (x *SearchFilter) Reset() {
+	*x = SearchFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anirent_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilter) ProtoMessage() {}
+
+func (x *SearchFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_anirent_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilter.ProtoReflect.Descriptor instead.
+func (*SearchFilter) Descriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchFilter) GetFirstEpisode() int64 {
+	if x != nil {
+		return x.FirstEpisode
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetLastEpisode() int64 {
+	if x != nil {
+		return x.LastEpisode
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetSeason() int64 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetReleaseType() ReleaseType {
+	if x != nil {
+		return x.ReleaseType
+	}
+	return ReleaseType_ANY_RELEASE
+}
+
+func (x *SearchFilter) GetMinVersion() int64 {
+	if x != nil {
+		return x.MinVersion
+	}
+	return 0
+}
+
+func (x *SearchFilter) GetExcludeVideoCodecs() []VideoCodec {
+	if x != nil {
+		return x.ExcludeVideoCodecs
+	}
+	return nil
+}
+
+func (x *SearchFilter) GetSources() []Source {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type SortOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The result attribute to sort by.
+	Key SortKey `protobuf:"varint,1,opt,name=key,proto3,enum=proto.SortKey" json:"key,omitempty"`
+	// Sort from largest to smallest instead of smallest to largest.
+	Descending bool `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+}
+
+func (x *SortOption) Reset() {
+	*x = SortOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anirent_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortOption) ProtoMessage() {}
+
+func (x *SortOption) ProtoReflect() protoreflect.Message {
+	mi := &file_anirent_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortOption.ProtoReflect.Descriptor instead.
+func (*SortOption) Descriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SortOption) GetKey() SortKey {
+	if x != nil {
+		return x.Key
+	}
+	return SortKey_UNSORTED
+}
+
+func (x *SortOption) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
 type SearchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -197,15 +867,42 @@ type SearchResult struct {
 	// Types that are assignable to Details:
 	//	*SearchResult_Episode
 	//	*SearchResult_Season
+	//	*SearchResult_Special
+	//	*SearchResult_Movie
 	Details isSearchResult_Details `protobuf_oneof:"details"`
 	// magnet link for downloading torrent content
 	Magnet string `protobuf:"bytes,6,opt,name=magnet,proto3" json:"magnet,omitempty"`
+	// The BitTorrent v1 infohash, in lowercase hex, identifying the torrent.
+	InfoHash string `protobuf:"bytes,7,opt,name=info_hash,json=infoHash,proto3" json:"info_hash,omitempty"`
+	// Total size in bytes of the torrent content. Zero if unknown.
+	Size int64 `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	// Number of peers with the complete torrent, as last reported by the provider.
+	Seeders int64 `protobuf:"varint,9,opt,name=seeders,proto3" json:"seeders,omitempty"`
+	// Number of peers still downloading the torrent, as last reported by the provider.
+	Leechers int64 `protobuf:"varint,10,opt,name=leechers,proto3" json:"leechers,omitempty"`
+	// When the torrent was uploaded. Unset if unknown.
+	UploadedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	// The group which released the content e.g. SubsPlease
+	ReleaseGroup string `protobuf:"bytes,12,opt,name=release_group,json=releaseGroup,proto3" json:"release_group,omitempty"`
+	// The name of the search provider which found this result e.g. btdig
+	Provider string `protobuf:"bytes,13,opt,name=provider,proto3" json:"provider,omitempty"`
+	// The revision of the release e.g. 2 for a fixed release labelled 08v2.
+	// Zero for the original release.
+	Revision int64 `protobuf:"varint,14,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The CRC32 checksum, in uppercase hex, the release group labelled the
+	// file with e.g. 37FBE4D6. Empty if the release isn't labelled.
+	Crc32 string `protobuf:"bytes,17,opt,name=crc32,proto3" json:"crc32,omitempty"`
+	// Technical details tagged in the torrent name e.g. HEVC or 10bit. Unset
+	// if the name has no tags.
+	MediaInfo *MediaInfo `protobuf:"bytes,18,opt,name=media_info,json=mediaInfo,proto3" json:"media_info,omitempty"`
+	// Where the name this result was parsed from came from.
+	NameSource NameSource `protobuf:"varint,19,opt,name=name_source,json=nameSource,proto3,enum=proto.NameSource" json:"name_source,omitempty"`
 }
 
 func (x *SearchResult) Reset() {
 	*x = SearchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[1]
+		mi := &file_anirent_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -218,7 +915,7 @@ func (x *SearchResult) String() string {
 func (*SearchResult) ProtoMessage() {}
 
 func (x *SearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[1]
+	mi := &file_anirent_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +928,7 @@ func (x *SearchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
 func (*SearchResult) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{1}
+	return file_anirent_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SearchResult) GetName() string {
@@ -252,7 +949,7 @@ func (x *SearchResult) GetFormat() Format {
 	if x != nil {
 		return x.Format
 	}
-	return Format_MKV
+	return Format_UNSPECIFIED_FORMAT
 }
 
 func (m *SearchResult) GetDetails() isSearchResult_Details {
@@ -269,36 +966,238 @@ func (x *SearchResult) GetEpisode() *Episode {
 	return nil
 }
 
-func (x *SearchResult) GetSeason() *CompleteSeason {
-	if x, ok := x.GetDetails().(*SearchResult_Season); ok {
-		return x.Season
+func (x *SearchResult) GetSeason() *CompleteSeason {
+	if x, ok := x.GetDetails().(*SearchResult_Season); ok {
+		return x.Season
+	}
+	return nil
+}
+
+func (x *SearchResult) GetSpecial() *Special {
+	if x, ok := x.GetDetails().(*SearchResult_Special); ok {
+		return x.Special
+	}
+	return nil
+}
+
+func (x *SearchResult) GetMovie() *Movie {
+	if x, ok := x.GetDetails().(*SearchResult_Movie); ok {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *SearchResult) GetMagnet() string {
+	if x != nil {
+		return x.Magnet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetInfoHash() string {
+	if x != nil {
+		return x.InfoHash
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSeeders() int64 {
+	if x != nil {
+		return x.Seeders
+	}
+	return 0
+}
+
+func (x *SearchResult) GetLeechers() int64 {
+	if x != nil {
+		return x.Leechers
+	}
+	return 0
+}
+
+func (x *SearchResult) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+func (x *SearchResult) GetReleaseGroup() string {
+	if x != nil {
+		return x.ReleaseGroup
+	}
+	return ""
+}
+
+func (x *SearchResult) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SearchResult) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *SearchResult) GetCrc32() string {
+	if x != nil {
+		return x.Crc32
+	}
+	return ""
+}
+
+func (x *SearchResult) GetMediaInfo() *MediaInfo {
+	if x != nil {
+		return x.MediaInfo
+	}
+	return nil
+}
+
+func (x *SearchResult) GetNameSource() NameSource {
+	if x != nil {
+		return x.NameSource
+	}
+	return NameSource_TORRENT_NAME
+}
+
+type isSearchResult_Details interface {
+	isSearchResult_Details()
+}
+
+type SearchResult_Episode struct {
+	Episode *Episode `protobuf:"bytes,4,opt,name=episode,proto3,oneof"`
+}
+
+type SearchResult_Season struct {
+	Season *CompleteSeason `protobuf:"bytes,5,opt,name=season,proto3,oneof"`
+}
+
+type SearchResult_Special struct {
+	Special *Special `protobuf:"bytes,15,opt,name=special,proto3,oneof"`
+}
+
+type SearchResult_Movie struct {
+	Movie *Movie `protobuf:"bytes,16,opt,name=movie,proto3,oneof"`
+}
+
+func (*SearchResult_Episode) isSearchResult_Details() {}
+
+func (*SearchResult_Season) isSearchResult_Details() {}
+
+func (*SearchResult_Special) isSearchResult_Details() {}
+
+func (*SearchResult_Movie) isSearchResult_Details() {}
+
+type MediaInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoCodec VideoCodec `protobuf:"varint,1,opt,name=video_codec,json=videoCodec,proto3,enum=proto.VideoCodec" json:"video_codec,omitempty"`
+	// Bits per color channel e.g. 10 for 10bit. Zero if unknown.
+	BitDepth    int64        `protobuf:"varint,2,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty"`
+	AudioCodecs []AudioCodec `protobuf:"varint,3,rep,packed,name=audio_codecs,json=audioCodecs,proto3,enum=proto.AudioCodec" json:"audio_codecs,omitempty"`
+	Source      Source       `protobuf:"varint,4,opt,name=source,proto3,enum=proto.Source" json:"source,omitempty"`
+	// Whether the release has both Japanese and dubbed audio.
+	DualAudio bool `protobuf:"varint,5,opt,name=dual_audio,json=dualAudio,proto3" json:"dual_audio,omitempty"`
+	// Whether the release has subtitles in multiple languages.
+	MultiSubs bool `protobuf:"varint,6,opt,name=multi_subs,json=multiSubs,proto3" json:"multi_subs,omitempty"`
+	// ISO 639-1 codes of the audio or subtitle languages tagged e.g. en
+	Languages []string `protobuf:"bytes,7,rep,name=languages,proto3" json:"languages,omitempty"`
+}
+
+func (x *MediaInfo) Reset() {
+	*x = MediaInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anirent_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaInfo) ProtoMessage() {}
+
+func (x *MediaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_anirent_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaInfo.ProtoReflect.Descriptor instead.
+func (*MediaInfo) Descriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MediaInfo) GetVideoCodec() VideoCodec {
+	if x != nil {
+		return x.VideoCodec
+	}
+	return VideoCodec_UNKNOWN_VIDEO_CODEC
+}
+
+func (x *MediaInfo) GetBitDepth() int64 {
+	if x != nil {
+		return x.BitDepth
+	}
+	return 0
+}
+
+func (x *MediaInfo) GetAudioCodecs() []AudioCodec {
+	if x != nil {
+		return x.AudioCodecs
 	}
 	return nil
 }
 
-func (x *SearchResult) GetMagnet() string {
+func (x *MediaInfo) GetSource() Source {
 	if x != nil {
-		return x.Magnet
+		return x.Source
 	}
-	return ""
+	return Source_UNKNOWN_SOURCE
 }
 
-type isSearchResult_Details interface {
-	isSearchResult_Details()
+func (x *MediaInfo) GetDualAudio() bool {
+	if x != nil {
+		return x.DualAudio
+	}
+	return false
 }
 
-type SearchResult_Episode struct {
-	Episode *Episode `protobuf:"bytes,4,opt,name=episode,proto3,oneof"`
+func (x *MediaInfo) GetMultiSubs() bool {
+	if x != nil {
+		return x.MultiSubs
+	}
+	return false
 }
 
-type SearchResult_Season struct {
-	Season *CompleteSeason `protobuf:"bytes,5,opt,name=season,proto3,oneof"`
+func (x *MediaInfo) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
 }
 
-func (*SearchResult_Episode) isSearchResult_Details() {}
-
-func (*SearchResult_Season) isSearchResult_Details() {}
-
 type DownloadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -311,7 +1210,7 @@ type DownloadRequest struct {
 func (x *DownloadRequest) Reset() {
 	*x = DownloadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[2]
+		mi := &file_anirent_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -324,7 +1223,7 @@ func (x *DownloadRequest) String() string {
 func (*DownloadRequest) ProtoMessage() {}
 
 func (x *DownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[2]
+	mi := &file_anirent_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -337,7 +1236,7 @@ func (x *DownloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadRequest.ProtoReflect.Descriptor instead.
 func (*DownloadRequest) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{2}
+	return file_anirent_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DownloadRequest) GetResult() *SearchResult {
@@ -358,7 +1257,7 @@ type DownloadResponse struct {
 func (x *DownloadResponse) Reset() {
 	*x = DownloadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[3]
+		mi := &file_anirent_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -371,7 +1270,7 @@ func (x *DownloadResponse) String() string {
 func (*DownloadResponse) ProtoMessage() {}
 
 func (x *DownloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[3]
+	mi := &file_anirent_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +1283,7 @@ func (x *DownloadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadResponse.ProtoReflect.Descriptor instead.
 func (*DownloadResponse) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{3}
+	return file_anirent_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DownloadResponse) GetSubscription() *Subscription {
@@ -405,7 +1304,7 @@ type Subscription struct {
 func (x *Subscription) Reset() {
 	*x = Subscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[4]
+		mi := &file_anirent_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -418,7 +1317,7 @@ func (x *Subscription) String() string {
 func (*Subscription) ProtoMessage() {}
 
 func (x *Subscription) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[4]
+	mi := &file_anirent_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +1330,7 @@ func (x *Subscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
 func (*Subscription) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{4}
+	return file_anirent_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Subscription) GetId() string {
@@ -461,7 +1360,7 @@ type Event struct {
 func (x *Event) Reset() {
 	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[5]
+		mi := &file_anirent_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -474,7 +1373,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[5]
+	mi := &file_anirent_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +1386,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{5}
+	return file_anirent_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Event) GetId() string {
@@ -578,12 +1477,15 @@ type DownloadStarted struct {
 	TotalBytes int64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
 	// Multi-address representing location of downloaded content.
 	MultiAddr string `protobuf:"bytes,3,opt,name=multi_addr,json=multiAddr,proto3" json:"multi_addr,omitempty"`
+	// The video file format of the downloaded content. Results which didn't
+	// specify a format, like batches, have it derived from the torrent's files.
+	Format Format `protobuf:"varint,4,opt,name=format,proto3,enum=proto.Format" json:"format,omitempty"`
 }
 
 func (x *DownloadStarted) Reset() {
 	*x = DownloadStarted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[6]
+		mi := &file_anirent_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -596,7 +1498,7 @@ func (x *DownloadStarted) String() string {
 func (*DownloadStarted) ProtoMessage() {}
 
 func (x *DownloadStarted) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[6]
+	mi := &file_anirent_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +1511,7 @@ func (x *DownloadStarted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadStarted.ProtoReflect.Descriptor instead.
 func (*DownloadStarted) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{6}
+	return file_anirent_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DownloadStarted) GetMagnet() string {
@@ -633,6 +1535,13 @@ func (x *DownloadStarted) GetMultiAddr() string {
 	return ""
 }
 
+func (x *DownloadStarted) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_UNSPECIFIED_FORMAT
+}
+
 type DownloadProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -651,7 +1560,7 @@ type DownloadProgress struct {
 func (x *DownloadProgress) Reset() {
 	*x = DownloadProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[7]
+		mi := &file_anirent_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -664,7 +1573,7 @@ func (x *DownloadProgress) String() string {
 func (*DownloadProgress) ProtoMessage() {}
 
 func (x *DownloadProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[7]
+	mi := &file_anirent_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,7 +1586,7 @@ func (x *DownloadProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadProgress.ProtoReflect.Descriptor instead.
 func (*DownloadProgress) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{7}
+	return file_anirent_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DownloadProgress) GetMagnet() string {
@@ -719,12 +1628,18 @@ type DownloadComplete struct {
 	TotalBytes int64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
 	// Multi-address representing location of downloaded content.
 	MultiAddr string `protobuf:"bytes,3,opt,name=multi_addr,json=multiAddr,proto3" json:"multi_addr,omitempty"`
+	// Whether the downloaded content matched the CRC32 label of the
+	// search result.
+	Verification Verification `protobuf:"varint,4,opt,name=verification,proto3,enum=proto.Verification" json:"verification,omitempty"`
+	// The CRC32 checksum, in uppercase hex, of the downloaded file. Empty
+	// if the download wasn't verified.
+	Crc32 string `protobuf:"bytes,5,opt,name=crc32,proto3" json:"crc32,omitempty"`
 }
 
 func (x *DownloadComplete) Reset() {
 	*x = DownloadComplete{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[8]
+		mi := &file_anirent_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -737,7 +1652,7 @@ func (x *DownloadComplete) String() string {
 func (*DownloadComplete) ProtoMessage() {}
 
 func (x *DownloadComplete) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[8]
+	mi := &file_anirent_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +1665,7 @@ func (x *DownloadComplete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadComplete.ProtoReflect.Descriptor instead.
 func (*DownloadComplete) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{8}
+	return file_anirent_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DownloadComplete) GetMagnet() string {
@@ -774,6 +1689,20 @@ func (x *DownloadComplete) GetMultiAddr() string {
 	return ""
 }
 
+func (x *DownloadComplete) GetVerification() Verification {
+	if x != nil {
+		return x.Verification
+	}
+	return Verification_UNVERIFIED
+}
+
+func (x *DownloadComplete) GetCrc32() string {
+	if x != nil {
+		return x.Crc32
+	}
+	return ""
+}
+
 type DownloadFailure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -788,7 +1717,7 @@ type DownloadFailure struct {
 func (x *DownloadFailure) Reset() {
 	*x = DownloadFailure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[9]
+		mi := &file_anirent_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -801,7 +1730,7 @@ func (x *DownloadFailure) String() string {
 func (*DownloadFailure) ProtoMessage() {}
 
 func (x *DownloadFailure) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[9]
+	mi := &file_anirent_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1743,7 @@ func (x *DownloadFailure) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadFailure.ProtoReflect.Descriptor instead.
 func (*DownloadFailure) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{9}
+	return file_anirent_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DownloadFailure) GetMagnet() string {
@@ -841,12 +1770,19 @@ type Episode struct {
 	// Where this episode falls in the order of all the episodes
 	// belonging to the given season.
 	Number int64 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// The revision of this episode e.g. 2 for 08v2. Zero for the
+	// original release.
+	Revision int64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The absolute number this episode was released under e.g. 1071,
+	// counting every episode across all seasons. Only set when the
+	// season and number were mapped from it.
+	Absolute int64 `protobuf:"varint,4,opt,name=absolute,proto3" json:"absolute,omitempty"`
 }
 
 func (x *Episode) Reset() {
 	*x = Episode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[10]
+		mi := &file_anirent_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -859,7 +1795,7 @@ func (x *Episode) String() string {
 func (*Episode) ProtoMessage() {}
 
 func (x *Episode) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[10]
+	mi := &file_anirent_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +1808,7 @@ func (x *Episode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Episode.ProtoReflect.Descriptor instead.
 func (*Episode) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{10}
+	return file_anirent_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Episode) GetSeason() int64 {
@@ -889,6 +1825,20 @@ func (x *Episode) GetNumber() int64 {
 	return 0
 }
 
+func (x *Episode) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Episode) GetAbsolute() int64 {
+	if x != nil {
+		return x.Absolute
+	}
+	return 0
+}
+
 type CompleteSeason struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -902,7 +1852,7 @@ type CompleteSeason struct {
 func (x *CompleteSeason) Reset() {
 	*x = CompleteSeason{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anirent_proto_msgTypes[11]
+		mi := &file_anirent_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -915,7 +1865,7 @@ func (x *CompleteSeason) String() string {
 func (*CompleteSeason) ProtoMessage() {}
 
 func (x *CompleteSeason) ProtoReflect() protoreflect.Message {
-	mi := &file_anirent_proto_msgTypes[11]
+	mi := &file_anirent_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1878,7 @@ func (x *CompleteSeason) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteSeason.ProtoReflect.Descriptor instead.
 func (*CompleteSeason) Descriptor() ([]byte, []int) {
-	return file_anirent_proto_rawDescGZIP(), []int{11}
+	return file_anirent_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CompleteSeason) GetNumber() int64 {
@@ -945,69 +1895,292 @@ func (x *CompleteSeason) GetEpisodes() []*Episode {
 	return nil
 }
 
+type Special struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The season this special was released alongside.
+	Season int64       `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	Kind   SpecialKind `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.SpecialKind" json:"kind,omitempty"`
+	// The number of this special e.g. 1 for SP1 or OVA 1. Zero if the
+	// special isn't numbered.
+	Number int64 `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	// The regular episode this special follows, for specials numbered
+	// between regular episodes e.g. 12 for 12.5. Zero otherwise.
+	AfterEpisode int64 `protobuf:"varint,4,opt,name=after_episode,json=afterEpisode,proto3" json:"after_episode,omitempty"`
+	// The digits following the decimal point of specials numbered between
+	// regular episodes, without trailing zeros e.g. 5 for 12.5. Empty
+	// otherwise.
+	Fraction string `protobuf:"bytes,5,opt,name=fraction,proto3" json:"fraction,omitempty"`
+}
+
+func (x *Special) Reset() {
+	*x = Special{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anirent_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Special) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Special) ProtoMessage() {}
+
+func (x *Special) ProtoReflect() protoreflect.Message {
+	mi := &file_anirent_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Special.ProtoReflect.Descriptor instead.
+func (*Special) Descriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Special) GetSeason() int64 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *Special) GetKind() SpecialKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SpecialKind_SPECIAL
+}
+
+func (x *Special) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Special) GetAfterEpisode() int64 {
+	if x != nil {
+		return x.AfterEpisode
+	}
+	return 0
+}
+
+func (x *Special) GetFraction() string {
+	if x != nil {
+		return x.Fraction
+	}
+	return ""
+}
+
+type Movie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Where this movie falls in the order of the anime's movies e.g.
+	// 2 for Movie 2. Zero if the movie isn't numbered.
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *Movie) Reset() {
+	*x = Movie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anirent_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Movie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Movie) ProtoMessage() {}
+
+func (x *Movie) ProtoReflect() protoreflect.Message {
+	mi := &file_anirent_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Movie.ProtoReflect.Descriptor instead.
+func (*Movie) Descriptor() ([]byte, []int) {
+	return file_anirent_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Movie) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
 var File_anirent_proto protoreflect.FileDescriptor
 
 var file_anirent_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x61, 0x6e, 0x69, 0x72, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x69, 0x6d, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6e, 0x69,
-	0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
-	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x0c,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x31, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x65, 0x70,
-	0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x07, 0x65,
-	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x42,
-	0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x3e, 0x0a, 0x0f, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a, 0x10, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1e, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa3, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x35,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x69,
+	0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x6e, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xb4, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x43, 0x0a, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63,
+	0x52, 0x12, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x63, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x4e, 0x0a,
+	0x0a, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xca, 0x05,
+	0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x07,
+	0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x07, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x05, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x76,
+	0x69, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x69, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x61, 0x67, 0x6e, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x67,
+	0x6e, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x6f, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x65, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x65, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x65, 0x65, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6c, 0x65, 0x65, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x72, 0x63, 0x33, 0x32, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x72, 0x63, 0x33, 0x32, 0x12, 0x2f, 0x0a, 0x0a, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x0b, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x09, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x0b, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63,
+	0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x62, 0x69, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x0c, 0x61, 0x75, 0x64,
+	0x69, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x63, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x73, 0x12,
+	0x25, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x75, 0x61, 0x6c, 0x5f, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x61, 0x6c,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x73,
+	0x75, 0x62, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x75, 0x62, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x73, 0x22, 0x3e, 0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x4b, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x1e, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xa3, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32,
-	0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75,
-	0x72, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x69, 0x0a,
-	0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x77,
+	0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x67,
+	0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x77,
 	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
 	0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
 	0x61, 0x67, 0x6e, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
@@ -1017,45 +2190,106 @@ var file_anirent_proto_rawDesc = []byte{
 	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65,
 	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72,
-	0x22, 0x6a, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x22, 0x3f, 0x0a, 0x0f,
+	0x22, 0xb9, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x37, 0x0a,
+	0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x72, 0x63, 0x33, 0x32, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x72, 0x63, 0x33, 0x32, 0x22, 0x3f, 0x0a, 0x0f,
 	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
 	0x16, 0x0a, 0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x06, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x39, 0x0a,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x71, 0x0a,
 	0x07, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73,
 	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
 	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x69,
-	0x73, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x2a, 0x11,
-	0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x4b, 0x56, 0x10,
-	0x00, 0x2a, 0x4e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x09, 0x0a, 0x05, 0x50, 0x5f, 0x33, 0x36, 0x30, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x5f,
-	0x34, 0x38, 0x30, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x5f, 0x37, 0x32, 0x30, 0x10, 0x02,
-	0x12, 0x0a, 0x0a, 0x06, 0x50, 0x5f, 0x31, 0x30, 0x38, 0x30, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
-	0x50, 0x5f, 0x32, 0x31, 0x36, 0x30, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x4b, 0x5f, 0x34, 0x10,
-	0x05, 0x32, 0xaf, 0x01, 0x0a, 0x07, 0x41, 0x6e, 0x69, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a,
-	0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x30, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x13,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x30, 0x01, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
+	0x22, 0x54, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x08, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x07, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x61, 0x66, 0x74, 0x65, 0x72, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x05, 0x4d,
+	0x6f, 0x76, 0x69, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2a, 0x43, 0x0a, 0x0b,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x41,
+	0x4e, 0x59, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x45, 0x50, 0x49, 0x53, 0x4f, 0x44, 0x45, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10,
+	0x02, 0x2a, 0x7a, 0x0a, 0x07, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x0c, 0x0a, 0x08,
+	0x55, 0x4e, 0x53, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x50,
+	0x49, 0x53, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x45, 0x45, 0x44, 0x45, 0x52, 0x53, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x49, 0x5a, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x10,
+	0x05, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x06, 0x2a, 0x37, 0x0a,
+	0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x54,
+	0x4f, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a,
+	0x13, 0x4d, 0x41, 0x47, 0x4e, 0x45, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x2a, 0x41, 0x0a, 0x0a, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43,
+	0x6f, 0x64, 0x65, 0x63, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
+	0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x56, 0x43, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x56, 0x43, 0x10, 0x02,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x56, 0x31, 0x10, 0x03, 0x2a, 0x5e, 0x0a, 0x0a, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x41, 0x43, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x4c, 0x41,
+	0x43, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x55, 0x53, 0x10, 0x03, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x43, 0x33, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x41, 0x43, 0x33, 0x10, 0x05,
+	0x12, 0x07, 0x0a, 0x03, 0x44, 0x54, 0x53, 0x10, 0x06, 0x2a, 0x42, 0x0a, 0x06, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x54, 0x56, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x44, 0x56, 0x44, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x57, 0x45, 0x42, 0x10,
+	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4c, 0x55, 0x52, 0x41, 0x59, 0x10, 0x04, 0x2a, 0x3a, 0x0a,
+	0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x0a, 0x55, 0x4e, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x2a, 0x4d, 0x0a, 0x06, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d,
+	0x4b, 0x56, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x50, 0x34, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x56, 0x49, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x42, 0x4d, 0x10, 0x04,
+	0x12, 0x06, 0x0a, 0x02, 0x54, 0x53, 0x10, 0x05, 0x2a, 0x4e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x5f, 0x33, 0x36, 0x30, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x5f, 0x34, 0x38, 0x30, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x50, 0x5f, 0x37, 0x32, 0x30, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x5f, 0x31, 0x30, 0x38,
+	0x30, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x5f, 0x32, 0x31, 0x36, 0x30, 0x10, 0x04, 0x12,
+	0x07, 0x0a, 0x03, 0x4b, 0x5f, 0x34, 0x10, 0x05, 0x2a, 0x23, 0x0a, 0x0b, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x41, 0x4c, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x56, 0x41, 0x10, 0x01, 0x32, 0xaf, 0x01,
+	0x0a, 0x07, 0x41, 0x6e, 0x69, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01,
+	0x12, 0x3b, 0x0a, 0x08, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42,
+	0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1070,48 +2304,79 @@ func file_anirent_proto_rawDescGZIP() []byte {
 	return file_anirent_proto_rawDescData
 }
 
-var file_anirent_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_anirent_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_anirent_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_anirent_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_anirent_proto_goTypes = []interface{}{
-	(Format)(0),              // 0: proto.Format
-	(Resolution)(0),          // 1: proto.Resolution
-	(*SearchRequest)(nil),    // 2: proto.SearchRequest
-	(*SearchResult)(nil),     // 3: proto.SearchResult
-	(*DownloadRequest)(nil),  // 4: proto.DownloadRequest
-	(*DownloadResponse)(nil), // 5: proto.DownloadResponse
-	(*Subscription)(nil),     // 6: proto.Subscription
-	(*Event)(nil),            // 7: proto.Event
-	(*DownloadStarted)(nil),  // 8: proto.DownloadStarted
-	(*DownloadProgress)(nil), // 9: proto.DownloadProgress
-	(*DownloadComplete)(nil), // 10: proto.DownloadComplete
-	(*DownloadFailure)(nil),  // 11: proto.DownloadFailure
-	(*Episode)(nil),          // 12: proto.Episode
-	(*CompleteSeason)(nil),   // 13: proto.CompleteSeason
+	(ReleaseType)(0),              // 0: proto.ReleaseType
+	(SortKey)(0),                  // 1: proto.SortKey
+	(NameSource)(0),               // 2: proto.NameSource
+	(VideoCodec)(0),               // 3: proto.VideoCodec
+	(AudioCodec)(0),               // 4: proto.AudioCodec
+	(Source)(0),                   // 5: proto.Source
+	(Verification)(0),             // 6: proto.Verification
+	(Format)(0),                   // 7: proto.Format
+	(Resolution)(0),               // 8: proto.Resolution
+	(SpecialKind)(0),              // 9: proto.SpecialKind
+	(*SearchRequest)(nil),         // 10: proto.SearchRequest
+	(*SearchFilter)(nil),          // 11: proto.SearchFilter
+	(*SortOption)(nil),            // 12: proto.SortOption
+	(*SearchResult)(nil),          // 13: proto.SearchResult
+	(*MediaInfo)(nil),             // 14: proto.MediaInfo
+	(*DownloadRequest)(nil),       // 15: proto.DownloadRequest
+	(*DownloadResponse)(nil),      // 16: proto.DownloadResponse
+	(*Subscription)(nil),          // 17: proto.Subscription
+	(*Event)(nil),                 // 18: proto.Event
+	(*DownloadStarted)(nil),       // 19: proto.DownloadStarted
+	(*DownloadProgress)(nil),      // 20: proto.DownloadProgress
+	(*DownloadComplete)(nil),      // 21: proto.DownloadComplete
+	(*DownloadFailure)(nil),       // 22: proto.DownloadFailure
+	(*Episode)(nil),               // 23: proto.Episode
+	(*CompleteSeason)(nil),        // 24: proto.CompleteSeason
+	(*Special)(nil),               // 25: proto.Special
+	(*Movie)(nil),                 // 26: proto.Movie
+	(*timestamppb.Timestamp)(nil), // 27: google.protobuf.Timestamp
 }
 var file_anirent_proto_depIdxs = []int32{
-	1,  // 0: proto.SearchRequest.resolutions:type_name -> proto.Resolution
-	1,  // 1: proto.SearchResult.resolution:type_name -> proto.Resolution
-	0,  // 2: proto.SearchResult.format:type_name -> proto.Format
-	12, // 3: proto.SearchResult.episode:type_name -> proto.Episode
-	13, // 4: proto.SearchResult.season:type_name -> proto.CompleteSeason
-	3,  // 5: proto.DownloadRequest.result:type_name -> proto.SearchResult
-	6,  // 6: proto.DownloadResponse.subscription:type_name -> proto.Subscription
-	8,  // 7: proto.Event.started:type_name -> proto.DownloadStarted
-	9,  // 8: proto.Event.progress:type_name -> proto.DownloadProgress
-	10, // 9: proto.Event.completed:type_name -> proto.DownloadComplete
-	11, // 10: proto.Event.failure:type_name -> proto.DownloadFailure
-	12, // 11: proto.CompleteSeason.episodes:type_name -> proto.Episode
-	2,  // 12: proto.Anirent.Search:input_type -> proto.SearchRequest
-	4,  // 13: proto.Anirent.Download:input_type -> proto.DownloadRequest
-	6,  // 14: proto.Anirent.Subscribe:input_type -> proto.Subscription
-	3,  // 15: proto.Anirent.Search:output_type -> proto.SearchResult
-	5,  // 16: proto.Anirent.Download:output_type -> proto.DownloadResponse
-	7,  // 17: proto.Anirent.Subscribe:output_type -> proto.Event
-	15, // [15:18] is the sub-list for method output_type
-	12, // [12:15] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	8,  // 0: proto.SearchRequest.resolutions:type_name -> proto.Resolution
+	12, // 1: proto.SearchRequest.sort:type_name -> proto.SortOption
+	11, // 2: proto.SearchRequest.filter:type_name -> proto.SearchFilter
+	0,  // 3: proto.SearchFilter.release_type:type_name -> proto.ReleaseType
+	3,  // 4: proto.SearchFilter.exclude_video_codecs:type_name -> proto.VideoCodec
+	5,  // 5: proto.SearchFilter.sources:type_name -> proto.Source
+	1,  // 6: proto.SortOption.key:type_name -> proto.SortKey
+	8,  // 7: proto.SearchResult.resolution:type_name -> proto.Resolution
+	7,  // 8: proto.SearchResult.format:type_name -> proto.Format
+	23, // 9: proto.SearchResult.episode:type_name -> proto.Episode
+	24, // 10: proto.SearchResult.season:type_name -> proto.CompleteSeason
+	25, // 11: proto.SearchResult.special:type_name -> proto.Special
+	26, // 12: proto.SearchResult.movie:type_name -> proto.Movie
+	27, // 13: proto.SearchResult.uploaded_at:type_name -> google.protobuf.Timestamp
+	14, // 14: proto.SearchResult.media_info:type_name -> proto.MediaInfo
+	2,  // 15: proto.SearchResult.name_source:type_name -> proto.NameSource
+	3,  // 16: proto.MediaInfo.video_codec:type_name -> proto.VideoCodec
+	4,  // 17: proto.MediaInfo.audio_codecs:type_name -> proto.AudioCodec
+	5,  // 18: proto.MediaInfo.source:type_name -> proto.Source
+	13, // 19: proto.DownloadRequest.result:type_name -> proto.SearchResult
+	17, // 20: proto.DownloadResponse.subscription:type_name -> proto.Subscription
+	19, // 21: proto.Event.started:type_name -> proto.DownloadStarted
+	20, // 22: proto.Event.progress:type_name -> proto.DownloadProgress
+	21, // 23: proto.Event.completed:type_name -> proto.DownloadComplete
+	22, // 24: proto.Event.failure:type_name -> proto.DownloadFailure
+	7,  // 25: proto.DownloadStarted.format:type_name -> proto.Format
+	6,  // 26: proto.DownloadComplete.verification:type_name -> proto.Verification
+	23, // 27: proto.CompleteSeason.episodes:type_name -> proto.Episode
+	9,  // 28: proto.Special.kind:type_name -> proto.SpecialKind
+	10, // 29: proto.Anirent.Search:input_type -> proto.SearchRequest
+	15, // 30: proto.Anirent.Download:input_type -> proto.DownloadRequest
+	17, // 31: proto.Anirent.Subscribe:input_type -> proto.Subscription
+	13, // 32: proto.Anirent.Search:output_type -> proto.SearchResult
+	16, // 33: proto.Anirent.Download:output_type -> proto.DownloadResponse
+	18, // 34: proto.Anirent.Subscribe:output_type -> proto.Event
+	32, // [32:35] is the sub-list for method output_type
+	29, // [29:32] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_anirent_proto_init() }
@@ -1133,7 +2398,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResult); i {
+			switch v := v.(*SearchFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1145,7 +2410,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadRequest); i {
+			switch v := v.(*SortOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1157,7 +2422,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadResponse); i {
+			switch v := v.(*SearchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1169,7 +2434,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Subscription); i {
+			switch v := v.(*MediaInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1181,7 +2446,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+			switch v := v.(*DownloadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1193,7 +2458,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadStarted); i {
+			switch v := v.(*DownloadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1205,7 +2470,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadProgress); i {
+			switch v := v.(*Subscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1217,7 +2482,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadComplete); i {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1229,7 +2494,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadFailure); i {
+			switch v := v.(*DownloadStarted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1241,7 +2506,7 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Episode); i {
+			switch v := v.(*DownloadProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1253,6 +2518,42 @@ func file_anirent_proto_init() {
 			}
 		}
 		file_anirent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadComplete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anirent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anirent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Episode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anirent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CompleteSeason); i {
 			case 0:
 				return &v.state
@@ -1264,12 +2565,38 @@ func file_anirent_proto_init() {
 				return nil
 			}
 		}
+		file_anirent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Special); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anirent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Movie); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_anirent_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_anirent_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*SearchResult_Episode)(nil),
 		(*SearchResult_Season)(nil),
+		(*SearchResult_Special)(nil),
+		(*SearchResult_Movie)(nil),
 	}
-	file_anirent_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_anirent_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*Event_Started)(nil),
 		(*Event_Progress)(nil),
 		(*Event_Completed)(nil),
@@ -1280,8 +2607,8 @@ func file_anirent_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_anirent_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      10,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
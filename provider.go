@@ -0,0 +1,263 @@
+package anirent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/Zaba505/anirent/proto"
+)
+
+// RawResult represents an unparsed torrent found by a SearchProvider. Not
+// every provider knows about every field so zero values mean unknown.
+type RawResult struct {
+	TorrentName string
+	Magnet      string
+
+	InfoHash    string
+	Size        int64 // in bytes
+	Seeders     int64
+	Leechers    int64
+	PublishedAt time.Time
+
+	// Provider is set by the ProviderRegistry to the name of the
+	// provider which found this result.
+	Provider string
+}
+
+// SearchProvider represents a source of torrents e.g. a torrent search
+// engine or an indexer feed.
+type SearchProvider interface {
+	// Name uniquely identifies the provider within a ProviderRegistry.
+	Name() string
+
+	// Search queries the provider and sends every torrent found to resultCh.
+	// Implementations must not close resultCh and should return once ctx
+	// is cancelled.
+	Search(ctx context.Context, req *pb.SearchRequest, resultCh chan<- RawResult) error
+}
+
+// DefaultReleaseGroup is searched for when a request doesn't specify any
+// release groups.
+const DefaultReleaseGroup = "SubsPlease"
+
+// releaseGroups returns the release groups a request is searching for.
+func releaseGroups(req *pb.SearchRequest) []string {
+	if len(req.ReleaseGroups) == 0 {
+		return []string{DefaultReleaseGroup}
+	}
+	return req.ReleaseGroups
+}
+
+// resultLimit enforces SearchRequest.MaxResults across all of the concurrent
+// requests a provider makes for a single search.
+type resultLimit struct {
+	max int64
+	n   int64
+}
+
+func newResultLimit(req *pb.SearchRequest) *resultLimit {
+	return &resultLimit{max: req.MaxResults}
+}
+
+// take reports whether another result may be sent and, if so, counts it.
+func (l *resultLimit) take() bool {
+	if l.max <= 0 {
+		return true
+	}
+	return atomic.AddInt64(&l.n, 1) <= l.max
+}
+
+// reached reports whether no more results may be sent.
+func (l *resultLimit) reached() bool {
+	return l.max > 0 && atomic.LoadInt64(&l.n) >= l.max
+}
+
+// ProviderRegistry tracks the search providers available to a Service and
+// whether or not each of them is enabled.
+type ProviderRegistry struct {
+	mu        sync.RWMutex
+	providers map[string]SearchProvider
+	disabled  map[string]bool
+}
+
+// NewProviderRegistry returns a registry with the given providers registered
+// and enabled.
+func NewProviderRegistry(providers ...SearchProvider) *ProviderRegistry {
+	r := &ProviderRegistry{
+		providers: make(map[string]SearchProvider, len(providers)),
+		disabled:  make(map[string]bool),
+	}
+	for _, p := range providers {
+		r.Register(p)
+	}
+	return r
+}
+
+// Register adds the provider to the registry and enables it. Any provider
+// previously registered with the same name is replaced.
+func (r *ProviderRegistry) Register(p SearchProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := p.Name()
+	r.providers[name] = p
+	delete(r.disabled, name)
+}
+
+// Enable marks a registered provider as enabled.
+func (r *ProviderRegistry) Enable(name string) error {
+	return r.setDisabled(name, false)
+}
+
+// Disable marks a registered provider as disabled. Disabled providers are
+// skipped when searching.
+func (r *ProviderRegistry) Disable(name string) error {
+	return r.setDisabled(name, true)
+}
+
+func (r *ProviderRegistry) setDisabled(name string, disabled bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.providers[name]; !exists {
+		return fmt.Errorf("anirent: no search provider named - %s", name)
+	}
+
+	if disabled {
+		r.disabled[name] = true
+		return nil
+	}
+	delete(r.disabled, name)
+	return nil
+}
+
+// Enabled returns all the enabled providers ordered by name.
+func (r *ProviderRegistry) Enabled() []SearchProvider {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	providers := make([]SearchProvider, 0, len(r.providers))
+	for name, p := range r.providers {
+		if r.disabled[name] {
+			continue
+		}
+		providers = append(providers, p)
+	}
+
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].Name() < providers[j].Name()
+	})
+	return providers
+}
+
+// ProviderError represents the failure of a single SearchProvider.
+type ProviderError struct {
+	Provider string
+	Err      error
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Provider, e.Err)
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// SearchError is returned by ProviderRegistry.Search when one or more
+// providers fail. Results from the other providers are still delivered.
+type SearchError struct {
+	// Providers is the number of providers which were searched.
+	Providers int
+
+	Failures []*ProviderError
+}
+
+func (e *SearchError) Error() string {
+	ss := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		ss = append(ss, failure.Error())
+	}
+	return fmt.Sprintf("anirent: %d of %d search providers failed - %s", len(e.Failures), e.Providers, strings.Join(ss, "; "))
+}
+
+// Partial reports whether at least one provider succeeded.
+func (e *SearchError) Partial() bool {
+	return len(e.Failures) < e.Providers
+}
+
+// Search fans the request out to every enabled provider and sends their
+// results to resultCh. resultCh is closed once all providers have returned.
+// If any provider fails a *SearchError is returned.
+func (r *ProviderRegistry) Search(ctx context.Context, req *pb.SearchRequest, resultCh chan<- RawResult) error {
+	defer close(resultCh)
+
+	providers := r.Enabled()
+
+	// A failing provider shouldn't cancel the others and every failure
+	// should be reported so an errgroup isn't used here.
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failures []*ProviderError
+	)
+	for _, p := range providers {
+		p := p
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := searchProvider(ctx, p, req, resultCh)
+			if err == nil {
+				return
+			}
+
+			mu.Lock()
+			failures = append(failures, &ProviderError{Provider: p.Name(), Err: err})
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].Provider < failures[j].Provider
+	})
+	return &SearchError{
+		Providers: len(providers),
+		Failures:  failures,
+	}
+}
+
+// searchProvider runs a single provider and tags each of its results with
+// the provider name before forwarding them to resultCh.
+func searchProvider(ctx context.Context, p SearchProvider, req *pb.SearchRequest, resultCh chan<- RawResult) error {
+	name := p.Name()
+
+	providerCh := make(chan RawResult)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		// The consumer of resultCh always drains it so forwarding
+		// never blocks forever, even once ctx is cancelled.
+		for result := range providerCh {
+			result.Provider = name
+			resultCh <- result
+		}
+	}()
+
+	err := p.Search(ctx, req, providerCh)
+	close(providerCh)
+	<-done
+	return err
+}
@@ -0,0 +1,98 @@
+package anirent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type staticProvider struct {
+	name    string
+	results []RawResult
+	err     error
+}
+
+func (p staticProvider) Name() string {
+	return p.name
+}
+
+func (p staticProvider) Search(ctx context.Context, req *pb.SearchRequest, resultCh chan<- RawResult) error {
+	for _, result := range p.results {
+		// The registry is responsible for tagging results
+		result.Provider = ""
+		resultCh <- result
+	}
+	return p.err
+}
+
+func collect(resultCh <-chan RawResult) []RawResult {
+	var results []RawResult
+	for result := range resultCh {
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestProviderRegistry(t *testing.T) {
+	a := staticProvider{
+		name:    "a",
+		results: []RawResult{{TorrentName: "a1", Provider: "a"}, {TorrentName: "a2", Provider: "a"}},
+	}
+	b := staticProvider{
+		name:    "b",
+		results: []RawResult{{TorrentName: "b1", Provider: "b"}},
+	}
+
+	t.Run("Fans Out To Enabled Providers", func(subT *testing.T) {
+		r := NewProviderRegistry(a, b)
+
+		resultCh := make(chan RawResult, 10)
+		err := r.Search(context.Background(), &pb.SearchRequest{}, resultCh)
+		if !assert.Nil(subT, err) {
+			return
+		}
+
+		assert.ElementsMatch(subT, append(a.results, b.results...), collect(resultCh))
+	})
+
+	t.Run("Skips Disabled Providers", func(subT *testing.T) {
+		r := NewProviderRegistry(a, b)
+		if !assert.Nil(subT, r.Disable("b")) {
+			return
+		}
+
+		resultCh := make(chan RawResult, 10)
+		err := r.Search(context.Background(), &pb.SearchRequest{}, resultCh)
+		if !assert.Nil(subT, err) {
+			return
+		}
+
+		assert.ElementsMatch(subT, a.results, collect(resultCh))
+	})
+
+	t.Run("Unknown Provider", func(subT *testing.T) {
+		r := NewProviderRegistry(a)
+		assert.NotNil(subT, r.Disable("b"))
+	})
+
+	t.Run("Provider Failure", func(subT *testing.T) {
+		r := NewProviderRegistry(a, staticProvider{name: "c", err: errors.New("down")})
+
+		resultCh := make(chan RawResult, 10)
+		err := r.Search(context.Background(), &pb.SearchRequest{}, resultCh)
+		assert.ElementsMatch(subT, a.results, collect(resultCh))
+
+		searchErr, ok := err.(*SearchError)
+		if !assert.True(subT, ok) {
+			return
+		}
+		assert.True(subT, searchErr.Partial())
+		if assert.Len(subT, searchErr.Failures, 1) {
+			assert.Equal(subT, "c", searchErr.Failures[0].Provider)
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package anirent
+
+import (
+	"sort"
+
+	pb "github.com/Zaba505/anirent/proto"
+)
+
+// sortResults orders results according to the sort options of the request.
+// Results which compare equal are ordered by revision, latest first, and
+// otherwise keep the order they were found in.
+func sortResults(results []*pb.SearchResult, req *pb.SearchRequest) {
+	if len(req.Sort) == 0 {
+		return
+	}
+
+	preference := make(map[pb.Resolution]int, len(req.Resolutions))
+	for i, res := range req.Resolutions {
+		if _, exists := preference[res]; !exists {
+			preference[res] = i
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		for _, opt := range req.Sort {
+			c := compareResults(opt.Key, results[i], results[j], preference)
+			if c == 0 {
+				continue
+			}
+			if opt.Descending {
+				return c > 0
+			}
+			return c < 0
+		}
+
+		// Prefer the latest revision of otherwise equal results.
+		return resultVersion(results[i]) > resultVersion(results[j])
+	})
+}
+
+// compareResults returns -1, 0 or 1 depending on whether a is less than,
+// equal to or greater than b according to key.
+func compareResults(key pb.SortKey, a, b *pb.SearchResult, preference map[pb.Resolution]int) int {
+	switch key {
+	case pb.SortKey_EPISODE_NUMBER:
+		aSeason, aNumber := episodeOrder(a)
+		bSeason, bNumber := episodeOrder(b)
+		if c := compareInt64(aSeason, bSeason); c != 0 {
+			return c
+		}
+		return compareInt64(aNumber, bNumber)
+	case pb.SortKey_SEEDERS:
+		return compareInt64(a.Seeders, b.Seeders)
+	case pb.SortKey_SIZE:
+		return compareInt64(a.Size, b.Size)
+	case pb.SortKey_UPLOAD_DATE:
+		// Results with an unknown upload date are treated as the oldest.
+		return compareInt64(uploadedAtUnix(a), uploadedAtUnix(b))
+	case pb.SortKey_RESOLUTION_PREFERENCE:
+		return compareInt64(resolutionRank(a.Resolution, preference), resolutionRank(b.Resolution, preference))
+	case pb.SortKey_SOURCE:
+		// Sources are enumerated from lowest to highest quality.
+		return compareInt64(int64(a.MediaInfo.GetSource()), int64(b.MediaInfo.GetSource()))
+	default:
+		return 0
+	}
+}
+
+// episodeOrder returns the season and episode number a result should be
+// ordered by.
+func episodeOrder(result *pb.SearchResult) (int64, int64) {
+	switch x := result.Details.(type) {
+	case *pb.SearchResult_Episode:
+		return x.Episode.Season, x.Episode.Number
+	case *pb.SearchResult_Season:
+		if len(x.Season.Episodes) == 0 {
+			return x.Season.Number, 0
+		}
+		return x.Season.Number, x.Season.Episodes[0].Number
+	case *pb.SearchResult_Special:
+		// Specials between regular episodes e.g. 12.5 are ordered with
+		// the episode they follow, other specials before the season's
+		// episodes.
+		return x.Special.Season, x.Special.AfterEpisode
+	default:
+		return 0, 0
+	}
+}
+
+func uploadedAtUnix(result *pb.SearchResult) int64 {
+	if result.UploadedAt == nil {
+		return 0
+	}
+	return result.UploadedAt.Seconds
+}
+
+// resolutionRank ranks unrequested resolutions after all requested ones.
+func resolutionRank(res pb.Resolution, preference map[pb.Resolution]int) int64 {
+	rank, ok := preference[res]
+	if !ok {
+		return int64(len(preference))
+	}
+	return int64(rank)
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
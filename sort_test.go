@@ -0,0 +1,97 @@
+package anirent
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func episode(season, number int64) *pb.SearchResult_Episode {
+	return &pb.SearchResult_Episode{
+		Episode: &pb.Episode{Season: season, Number: number},
+	}
+}
+
+func TestSortResults(t *testing.T) {
+	uploadedAt := time.Date(2020, time.November, 21, 16, 31, 26, 0, time.UTC)
+
+	e1 := &pb.SearchResult{Name: "e1", Details: episode(1, 1), Seeders: 10, Resolution: pb.Resolution_P_720}
+	e2 := &pb.SearchResult{Name: "e2", Details: episode(1, 2), Seeders: 30, Resolution: pb.Resolution_P_1080, UploadedAt: timestamppb.New(uploadedAt)}
+	e2hd := &pb.SearchResult{Name: "e2hd", Details: episode(1, 2), Seeders: 20, Resolution: pb.Resolution_P_2160}
+	s2e1 := &pb.SearchResult{Name: "s2e1", Details: episode(2, 1), Seeders: 5, Resolution: pb.Resolution_P_1080, Size: 10}
+	batch := &pb.SearchResult{
+		Name: "batch",
+		Details: &pb.SearchResult_Season{
+			Season: &pb.CompleteSeason{
+				Number:   1,
+				Episodes: []*pb.Episode{{Season: 1, Number: 1}, {Season: 1, Number: 2}},
+			},
+		},
+		Resolution: pb.Resolution_P_1080,
+		Size:       100,
+	}
+
+	found := []*pb.SearchResult{s2e1, e2hd, batch, e2, e1}
+
+	testCases := []struct {
+		Name     string
+		Sort     []*pb.SortOption
+		Expected []*pb.SearchResult
+	}{
+		{
+			Name:     "Unsorted",
+			Expected: []*pb.SearchResult{s2e1, e2hd, batch, e2, e1},
+		},
+		{
+			Name:     "Episode Number",
+			Sort:     []*pb.SortOption{{Key: pb.SortKey_EPISODE_NUMBER}},
+			Expected: []*pb.SearchResult{batch, e1, e2hd, e2, s2e1},
+		},
+		{
+			Name:     "Most Seeded",
+			Sort:     []*pb.SortOption{{Key: pb.SortKey_SEEDERS, Descending: true}},
+			Expected: []*pb.SearchResult{e2, e2hd, e1, s2e1, batch},
+		},
+		{
+			Name:     "Largest",
+			Sort:     []*pb.SortOption{{Key: pb.SortKey_SIZE, Descending: true}},
+			Expected: []*pb.SearchResult{batch, s2e1, e2hd, e2, e1},
+		},
+		{
+			Name:     "Newest",
+			Sort:     []*pb.SortOption{{Key: pb.SortKey_UPLOAD_DATE, Descending: true}},
+			Expected: []*pb.SearchResult{e2, s2e1, e2hd, batch, e1},
+		},
+		{
+			Name: "Episode Then Resolution Preference",
+			Sort: []*pb.SortOption{
+				{Key: pb.SortKey_EPISODE_NUMBER},
+				{Key: pb.SortKey_RESOLUTION_PREFERENCE},
+			},
+			Expected: []*pb.SearchResult{batch, e1, e2, e2hd, s2e1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			results := append([]*pb.SearchResult(nil), found...)
+			sortResults(results, &pb.SearchRequest{
+				Resolutions: []pb.Resolution{pb.Resolution_P_1080, pb.Resolution_P_720},
+				Sort:        testCase.Sort,
+			})
+
+			names := func(results []*pb.SearchResult) []string {
+				ss := make([]string, 0, len(results))
+				for _, result := range results {
+					ss = append(ss, result.Name)
+				}
+				return ss
+			}
+			assert.Equal(subT, names(testCase.Expected), names(results))
+		})
+	}
+}
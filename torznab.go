@@ -0,0 +1,305 @@
+package anirent
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Zaba505/anirent/parser"
+	pb "github.com/Zaba505/anirent/proto"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// torznabAnimeCategory is the standard newznab category for TV/Anime.
+const torznabAnimeCategory = "5070"
+
+type torznab struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+
+	mu   sync.Mutex
+	caps *torznabCaps
+}
+
+// Torznab returns a SearchProvider which queries a Torznab compatible
+// indexer, or aggregator like Jackett or Prowlarr, hosted at baseURL.
+func Torznab(baseURL, apiKey string) SearchProvider {
+	return &torznab{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  http.DefaultClient,
+	}
+}
+
+func (*torznab) Name() string {
+	return "torznab"
+}
+
+// torznabQuery represents the parameters of a t=search or t=tvsearch request.
+type torznabQuery struct {
+	Q       string
+	Season  int64
+	Episode int64
+	Limit   int64
+}
+
+func (t *torznab) Search(ctx context.Context, req *pb.SearchRequest, resultCh chan<- RawResult) error {
+	caps, err := t.getCaps(ctx)
+	if err != nil {
+		return err
+	}
+
+	limit := newResultLimit(req)
+
+	g, ctx := errgroup.WithContext(ctx)
+	for _, group := range releaseGroups(req) {
+		for _, resolution := range req.Resolutions {
+			res := parser.SprintResolution(resolution)
+			q := torznabQuery{
+				Q:     fmt.Sprintf("%s %s %s", group, req.AnimeName, res),
+				Limit: req.MaxResults,
+			}
+
+			// Narrowing by episode is only possible when the filter
+			// targets a single episode.
+			if filter := req.Filter; filter != nil {
+				q.Season = filter.Season
+				if filter.FirstEpisode > 0 && filter.FirstEpisode == filter.LastEpisode {
+					q.Episode = filter.FirstEpisode
+				}
+			}
+
+			g.Go(func() error {
+				return t.searchIndexer(ctx, caps, q, limit, resultCh)
+			})
+		}
+	}
+
+	return g.Wait()
+}
+
+func (t *torznab) searchIndexer(ctx context.Context, caps *torznabCaps, q torznabQuery, limit *resultLimit, resultCh chan<- RawResult) error {
+	items, err := t.search(ctx, caps, q)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if !limit.take() {
+			return nil
+		}
+
+		result, err := item.toRawResult()
+		if err != nil {
+			zap.L().Warn("skipping malformed torznab item", zap.String("title", item.Title), zap.Error(err))
+			continue
+		}
+
+		zap.L().Debug("found search result", zap.String("torrent_name", result.TorrentName), zap.String("magnet", result.Magnet))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case resultCh <- result:
+		}
+	}
+	return nil
+}
+
+func (t *torznab) getCaps(ctx context.Context) (*torznabCaps, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.caps != nil {
+		return t.caps, nil
+	}
+
+	var resp torznabResponse
+	err := t.get(ctx, url.Values{"t": {"caps"}}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.XMLName.Local != "caps" {
+		return nil, fmt.Errorf("unexpected caps response - <%s>", resp.XMLName.Local)
+	}
+
+	t.caps = &resp.torznabCaps
+	return t.caps, nil
+}
+
+func (t *torznab) search(ctx context.Context, caps *torznabCaps, q torznabQuery) ([]torznabItem, error) {
+	params := url.Values{}
+	params.Set("cat", torznabAnimeCategory)
+	params.Set("q", q.Q)
+	params.Set("extended", "1")
+	if q.Limit > 0 {
+		params.Set("limit", strconv.FormatInt(q.Limit, 10))
+	}
+
+	// Prefer tv-search since it lets the indexer match the season and
+	// episode instead of relying on the query string alone.
+	switch {
+	case caps.Searching.TVSearch.Available == "yes":
+		params.Set("t", "tvsearch")
+		if q.Season > 0 && caps.Searching.TVSearch.supports("season") {
+			params.Set("season", strconv.FormatInt(q.Season, 10))
+		}
+		if q.Episode > 0 && caps.Searching.TVSearch.supports("ep") {
+			params.Set("ep", strconv.FormatInt(q.Episode, 10))
+		}
+	case caps.Searching.Search.Available == "yes":
+		params.Set("t", "search")
+	default:
+		return nil, fmt.Errorf("indexer does not support searching")
+	}
+
+	var resp torznabResponse
+	err := t.get(ctx, params, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Channel.Items, nil
+}
+
+func (t *torznab) get(ctx context.Context, params url.Values, v *torznabResponse) error {
+	if t.apiKey != "" {
+		params.Set("apikey", t.apiKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.baseURL+"/api?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "anirent-scraper")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status from torznab api - %s", resp.Status)
+	}
+
+	err = xml.NewDecoder(resp.Body).Decode(v)
+	if err != nil {
+		return err
+	}
+
+	// Torznab reports errors, like a bad api key, with a 200 status
+	// and an <error> document.
+	if v.XMLName.Local == "error" {
+		return fmt.Errorf("torznab error %s - %s", v.Code, v.Description)
+	}
+	return nil
+}
+
+// torznabResponse covers every document the api returns: <caps>, <rss>
+// and <error>.
+type torznabResponse struct {
+	XMLName xml.Name
+
+	// <error>
+	Code        string `xml:"code,attr"`
+	Description string `xml:"description,attr"`
+
+	// <caps>
+	torznabCaps
+
+	// <rss>
+	Channel struct {
+		Items []torznabItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type torznabCaps struct {
+	Searching struct {
+		Search   torznabSearchCaps `xml:"search"`
+		TVSearch torznabSearchCaps `xml:"tv-search"`
+	} `xml:"searching"`
+}
+
+type torznabSearchCaps struct {
+	Available       string `xml:"available,attr"`
+	SupportedParams string `xml:"supportedParams,attr"`
+}
+
+func (c torznabSearchCaps) supports(param string) bool {
+	for _, p := range strings.Split(c.SupportedParams, ",") {
+		if strings.TrimSpace(p) == param {
+			return true
+		}
+	}
+	return false
+}
+
+type torznabItem struct {
+	Title   string `xml:"title"`
+	Link    string `xml:"link"`
+	Size    int64  `xml:"size"`
+	PubDate string `xml:"pubDate"`
+	Attrs   []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value,attr"`
+	} `xml:"attr"`
+}
+
+func (item torznabItem) attr(name string) string {
+	for _, attr := range item.Attrs {
+		if attr.Name == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func (item torznabItem) intAttr(name string) int64 {
+	n, _ := strconv.ParseInt(item.attr(name), 10, 64)
+	return n
+}
+
+func (item torznabItem) toRawResult() (RawResult, error) {
+	result := RawResult{
+		TorrentName: strings.TrimSpace(item.Title),
+		InfoHash:    strings.ToLower(item.attr("infohash")),
+		Size:        item.Size,
+		Seeders:     item.intAttr("seeders"),
+	}
+
+	// peers includes seeders
+	if peers := item.intAttr("peers"); peers > result.Seeders {
+		result.Leechers = peers - result.Seeders
+	}
+	if result.Size == 0 {
+		result.Size = item.intAttr("size")
+	}
+
+	switch magnet := item.attr("magneturl"); {
+	case magnet != "":
+		result.Magnet = magnet
+	case strings.HasPrefix(item.Link, "magnet:"):
+		result.Magnet = item.Link
+	case result.InfoHash != "":
+		result.Magnet = buildMagnet(result.InfoHash, result.TorrentName)
+	default:
+		return result, fmt.Errorf("no magnet link or infohash")
+	}
+
+	if item.PubDate != "" {
+		pubDate, err := parsePubDate(item.PubDate)
+		if err != nil {
+			return result, err
+		}
+		result.PublishedAt = pubDate
+	}
+
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package anirent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTorznabServer(apiKey string, queries chan<- url.Values) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		if params.Get("apikey") != apiKey {
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><error code="100" description="Invalid API Key" />`))
+			return
+		}
+
+		switch params.Get("t") {
+		case "caps":
+			http.ServeFile(w, r, "testdata/torznab_caps.xml")
+		default:
+			queries <- params
+			http.ServeFile(w, r, "testdata/torznab_search.xml")
+		}
+	}))
+}
+
+func TestTorznabSearch(t *testing.T) {
+	queries := make(chan url.Values, 1)
+	srv := newTorznabServer("secret", queries)
+	defer srv.Close()
+
+	resultCh := make(chan RawResult, 10)
+	err := Torznab(srv.URL, "secret").Search(context.Background(), &pb.SearchRequest{
+		AnimeName:   "Tonikaku Kawaii",
+		Resolutions: []pb.Resolution{pb.Resolution_P_1080},
+	}, resultCh)
+	close(resultCh)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	params := <-queries
+	assert.Equal(t, "tvsearch", params.Get("t"))
+	assert.Equal(t, "SubsPlease Tonikaku Kawaii 1080p", params.Get("q"))
+	assert.Equal(t, torznabAnimeCategory, params.Get("cat"))
+
+	assert.Equal(t, []RawResult{
+		{
+			TorrentName: "[SubsPlease] Tonikaku Kawaii - 08 (1080p) [37FBE4D6].mkv",
+			Magnet:      "magnet:?xt=urn:btih:8E0A5A0E1C2E3B4D5F60718293A4B5C6D7E8F901&dn=%5BSubsPlease%5D+Tonikaku+Kawaii+-+08+%281080p%29+%5B37FBE4D6%5D.mkv",
+			InfoHash:    "8e0a5a0e1c2e3b4d5f60718293a4b5c6d7e8f901",
+			Size:        1503238553,
+			Seeders:     512,
+			Leechers:    3,
+			PublishedAt: time.Date(2020, time.November, 21, 16, 31, 26, 0, time.UTC),
+		},
+		{
+			TorrentName: "[SubsPlease] Tonikaku Kawaii (01-12) (1080p) [Batch]",
+			Magnet:      "magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567&dn=%5BSubsPlease%5D+Tonikaku+Kawaii+%2801-12%29+%281080p%29+%5BBatch%5D",
+			InfoHash:    "0123456789abcdef0123456789abcdef01234567",
+			Size:        18038862643,
+			Seeders:     87,
+			Leechers:    1,
+			PublishedAt: time.Date(2020, time.December, 19, 18, 2, 11, 0, time.UTC),
+		},
+	}, collect(resultCh))
+}
+
+func TestTorznabSearchInvalidAPIKey(t *testing.T) {
+	srv := newTorznabServer("secret", nil)
+	defer srv.Close()
+
+	resultCh := make(chan RawResult, 10)
+	err := Torznab(srv.URL, "wrong").Search(context.Background(), &pb.SearchRequest{
+		AnimeName:   "Tonikaku Kawaii",
+		Resolutions: []pb.Resolution{pb.Resolution_P_1080},
+	}, resultCh)
+	if !assert.NotNil(t, err) {
+		return
+	}
+	assert.Contains(t, err.Error(), "Invalid API Key")
+}
@@ -0,0 +1,34 @@
+package anirent
+
+import (
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"strings"
+
+	pb "github.com/Zaba505/anirent/proto"
+)
+
+// verifyCRC32 computes the CRC32 checksum of the file at path and compares
+// it against the label the release group gave it. The checksum is returned
+// in uppercase hex.
+func verifyCRC32(path, label string) (pb.Verification, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return pb.Verification_UNVERIFIED, "", err
+	}
+	defer f.Close()
+
+	h := crc32.NewIEEE()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return pb.Verification_UNVERIFIED, "", err
+	}
+
+	sum := fmt.Sprintf("%08X", h.Sum32())
+	if !strings.EqualFold(sum, label) {
+		return pb.Verification_MISMATCH, sum, nil
+	}
+	return pb.Verification_VERIFIED, sum, nil
+}
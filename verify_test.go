@@ -0,0 +1,59 @@
+package anirent
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/Zaba505/anirent/proto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyCRC32(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "episode.mkv")
+	err := os.WriteFile(path, []byte("The quick brown fox jumps over the lazy dog"), 0o644)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	testCases := []struct {
+		Name         string
+		Label        string
+		Verification pb.Verification
+	}{
+		{
+			Name:         "Matching Label",
+			Label:        "414FA339",
+			Verification: pb.Verification_VERIFIED,
+		},
+		{
+			Name:         "Lowercase Label",
+			Label:        "414fa339",
+			Verification: pb.Verification_VERIFIED,
+		},
+		{
+			Name:         "Mismatched Label",
+			Label:        "37FBE4D6",
+			Verification: pb.Verification_MISMATCH,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			verification, sum, err := verifyCRC32(path, testCase.Label)
+			if !assert.Nil(subT, err) {
+				return
+			}
+
+			assert.Equal(subT, testCase.Verification, verification)
+			assert.Equal(subT, "414FA339", sum)
+		})
+	}
+}
+
+func TestVerifyCRC32MissingFile(t *testing.T) {
+	verification, _, err := verifyCRC32(filepath.Join(t.TempDir(), "missing.mkv"), "414FA339")
+	assert.NotNil(t, err)
+	assert.Equal(t, pb.Verification_UNVERIFIED, verification)
+}